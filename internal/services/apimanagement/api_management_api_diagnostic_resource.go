@@ -1,6 +1,7 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -119,10 +120,35 @@ func resourceApiManagementApiDiagnostic() *pluginsdk.Resource {
 					string(apimanagement.URL),
 				}, false),
 			},
+
+			"require_body_masking": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(apiManagementDiagnosticRequireBodyMaskingCustomizeDiff),
 	}
 }
 
+// apiManagementDiagnosticRequireBodyMaskingCustomizeDiff ensures that no request/response bodies are logged when
+// `require_body_masking` is enabled, since `data_masking` only applies to the headers and query parameters - meaning
+// that any body which is logged can't be masked and may contain secrets
+func apiManagementDiagnosticRequireBodyMaskingCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.Get("require_body_masking").(bool) {
+		return nil
+	}
+
+	for _, key := range []string{"frontend_request", "frontend_response", "backend_request", "backend_response"} {
+		if bodyBytes := d.Get(fmt.Sprintf("%s.0.body_bytes", key)).(int); bodyBytes > 0 {
+			return fmt.Errorf("`%s.0.body_bytes` must be `0` when `require_body_masking` is enabled, since `data_masking` can't be applied to the body - got %d", key, bodyBytes)
+		}
+	}
+
+	return nil
+}
+
 func resourceApiManagementApiDiagnosticAdditionalContentSchema() *pluginsdk.Schema {
 	//lintignore:XS003
 	return &pluginsdk.Schema{
@@ -312,6 +338,9 @@ func resourceApiManagementApiDiagnosticRead(d *pluginsdk.ResourceData, meta inte
 		d.Set("operation_name_format", format)
 	}
 
+	// this isn't returned from the API, so default this when it's not set (e.g. during import)
+	d.Set("require_body_masking", d.Get("require_body_masking").(bool))
+
 	return nil
 }
 
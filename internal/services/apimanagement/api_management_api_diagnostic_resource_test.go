@@ -3,6 +3,7 @@ package apimanagement_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -127,6 +128,26 @@ func TestAccApiManagementApiDiagnostic_dataMasking(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementApiDiagnostic_requireBodyMasking(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_api_diagnostic", "test")
+	r := ApiManagementApiDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.requireBodyMasking(data, 32),
+			ExpectError: regexp.MustCompile("`frontend_request.0.body_bytes` must be `0` when `require_body_masking` is enabled"),
+		},
+		{
+			Config: r.requireBodyMasking(data, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_body_masking").HasValue("true"),
+			),
+		},
+		data.ImportStep("require_body_masking"),
+	})
+}
+
 func (ApiManagementApiDiagnosticResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ApiDiagnosticID(state.ID)
 	if err != nil {
@@ -426,3 +447,29 @@ resource "azurerm_api_management_api_diagnostic" "test" {
 }
 `, r.template(data))
 }
+
+func (r ApiManagementApiDiagnosticResource) requireBodyMasking(data acceptance.TestData, bodyBytes int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_api_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_name                 = azurerm_api_management_api.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+  require_body_masking     = true
+
+  frontend_request {
+    body_bytes = %d
+
+    data_masking {
+      headers {
+        mode  = "Hide"
+        value = "Authorization"
+      }
+    }
+  }
+}
+`, r.template(data), bodyBytes)
+}
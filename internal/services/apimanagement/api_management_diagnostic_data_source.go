@@ -0,0 +1,163 @@
+package apimanagement
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceApiManagementDiagnostic() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceApiManagementDiagnosticRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"identifier": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"api_management_name": schemaz.SchemaApiManagementDataSourceName(),
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"api_management_logger_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"sampling_percentage": {
+				Type:     pluginsdk.TypeFloat,
+				Computed: true,
+			},
+
+			"verbosity": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"http_correlation_protocol": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"frontend_request": dataSourceApiManagementDiagnosticAdditionalContentSchema(),
+
+			"frontend_response": dataSourceApiManagementDiagnosticAdditionalContentSchema(),
+
+			"backend_request": dataSourceApiManagementDiagnosticAdditionalContentSchema(),
+
+			"backend_response": dataSourceApiManagementDiagnosticAdditionalContentSchema(),
+		},
+	}
+}
+
+func dataSourceApiManagementDiagnosticAdditionalContentSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"body_bytes": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+				"headers_to_log": {
+					Type:     pluginsdk.TypeSet,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Set: pluginsdk.HashString,
+				},
+				"data_masking": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"query_params": dataSourceApiManagementDiagnosticDataMaskingEntityListSchema(),
+							"headers":      dataSourceApiManagementDiagnosticDataMaskingEntityListSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiManagementDiagnosticDataMaskingEntityListSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"mode": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiManagementDiagnosticRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.DiagnosticClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewDiagnosticID(subscriptionId, d.Get("resource_group_name").(string), d.Get("api_management_name").(string), d.Get("identifier").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("api_management_logger_id", resp.LoggerID)
+	if props := resp.DiagnosticContractProperties; props != nil {
+		// when Sampling isn't configured the API logs all requests, so the effective percentage is 100
+		samplingPercentage := 100.0
+		if props.Sampling != nil && props.Sampling.Percentage != nil {
+			samplingPercentage = *props.Sampling.Percentage
+		}
+		d.Set("sampling_percentage", samplingPercentage)
+		d.Set("verbosity", props.Verbosity)
+		d.Set("http_correlation_protocol", props.HTTPCorrelationProtocol)
+		if frontend := props.Frontend; frontend != nil {
+			d.Set("frontend_request", flattenApiManagementApiDiagnosticHTTPMessageDiagnostic(frontend.Request))
+			d.Set("frontend_response", flattenApiManagementApiDiagnosticHTTPMessageDiagnostic(frontend.Response))
+		} else {
+			d.Set("frontend_request", nil)
+			d.Set("frontend_response", nil)
+		}
+		if backend := props.Backend; backend != nil {
+			d.Set("backend_request", flattenApiManagementApiDiagnosticHTTPMessageDiagnostic(backend.Request))
+			d.Set("backend_response", flattenApiManagementApiDiagnosticHTTPMessageDiagnostic(backend.Response))
+		} else {
+			d.Set("backend_request", nil)
+			d.Set("backend_response", nil)
+		}
+	}
+
+	return nil
+}
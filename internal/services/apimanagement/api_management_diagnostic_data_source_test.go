@@ -0,0 +1,43 @@
+package apimanagement_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ApiManagementDiagnosticDataSource struct {
+}
+
+func TestAccDataSourceApiManagementDiagnostic_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("api_management_logger_id").Exists(),
+				check.That(data.ResourceName).Key("sampling_percentage").HasValue("11.1"),
+				check.That(data.ResourceName).Key("verbosity").HasValue("error"),
+				check.That(data.ResourceName).Key("http_correlation_protocol").HasValue("Legacy"),
+				check.That(data.ResourceName).Key("frontend_request.0.body_bytes").HasValue("100"),
+				check.That(data.ResourceName).Key("backend_response.0.headers_to_log.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (ApiManagementDiagnosticDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_api_management_diagnostic" "test" {
+  identifier          = azurerm_api_management_diagnostic.test.identifier
+  api_management_name = azurerm_api_management_diagnostic.test.api_management_name
+  resource_group_name = azurerm_api_management_diagnostic.test.resource_group_name
+}
+`, ApiManagementDiagnosticResource{}.complete(data))
+}
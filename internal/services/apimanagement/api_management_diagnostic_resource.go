@@ -1,6 +1,7 @@
 package apimanagement
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/schemaz"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/apimanagement/validate"
@@ -30,6 +32,11 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 			return err
 		}),
 
+		SchemaVersion: 1,
+		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
+			0: migration.DiagnosticV0ToV1{},
+		}),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -58,12 +65,6 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 				ValidateFunc: validate.LoggerID,
 			},
 
-			"enabled": {
-				Type:       pluginsdk.TypeBool,
-				Optional:   true,
-				Deprecated: "this property has been removed from the API and will be removed in version 3.0 of the provider",
-			},
-
 			"sampling_percentage": {
 				Type:         pluginsdk.TypeFloat,
 				Optional:     true,
@@ -71,12 +72,25 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 				ValidateFunc: validation.FloatBetween(0.0, 100.0),
 			},
 
+			"sampling_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Default:  string(apimanagement.Fixed),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(apimanagement.Fixed),
+				}, false),
+			},
+
 			"always_log_errors": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
 				Computed: true,
 			},
 
+			// TODO: add `error_body_bytes` should an API Version expose body logging specific to errors - at this time
+			// `HTTPMessageDiagnostic` is only available for the `frontend`/`backend` requests and responses, which apply
+			// to all logged requests (with `always_log_errors` only bypassing the sampling for errors)
+
 			"verbosity": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -113,19 +127,93 @@ func resourceApiManagementDiagnostic() *pluginsdk.Resource {
 
 			"backend_response": resourceApiManagementApiDiagnosticAdditionalContentSchema(),
 
+			// TODO: add `metrics` once the SDK has been updated to an API Version which exposes `Metrics` within `DiagnosticContractProperties` (2021-04-01-preview+)
+
 			"operation_name_format": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
-				Default:  string(apimanagement.Name),
+				Computed: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					string(apimanagement.Name),
 					string(apimanagement.URL),
 				}, false),
+				DiffSuppressFunc: func(_, old, new string, d *pluginsdk.ResourceData) bool {
+					return d.Id() != "" && apiManagementDiagnosticOperationNameFormatIsEquivalent(old, new)
+				},
+			},
+
+			"require_body_masking": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.Get("identifier").(string) == "applicationinsights" {
+					return nil
+				}
+
+				// `operation_name_format` is Optional & Computed, and Diagnostics created by earlier versions of the
+				// Provider have `Name` in the state - so only a value which is newly specified in the config is an error
+				if v := d.Get("operation_name_format").(string); v != "" && (d.Id() == "" || d.HasChange("operation_name_format")) {
+					return fmt.Errorf("`operation_name_format` can only be specified when `identifier` is `applicationinsights`")
+				}
+				return nil
+			}),
+			pluginsdk.CustomizeDiffShim(apiManagementDiagnosticLoggerTypeCustomizeDiff),
+			pluginsdk.CustomizeDiffShim(apiManagementDiagnosticRequireBodyMaskingCustomizeDiff),
+		),
 	}
 }
 
+// apiManagementDiagnosticOperationNameFormatIsEquivalent returns whether the `operation_name_format` returned from the API
+// is equivalent to the one in the config - Diagnostics created prior to this field being available return an empty
+// value, which the API treats as `Name`
+func apiManagementDiagnosticOperationNameFormatIsEquivalent(old, new string) bool {
+	return old == new || (old == "" && new == string(apimanagement.Name))
+}
+
+// apiManagementDiagnosticLoggerTypeCustomizeDiff ensures that the Logger referenced by `api_management_logger_id` is of
+// the type required by the `identifier`, since the API otherwise returns an unclear error during the apply
+func apiManagementDiagnosticLoggerTypeCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	// the Logger is commonly provisioned in the same apply, in which case this can only be checked by the API
+	if !d.NewValueKnown("api_management_logger_id") {
+		return nil
+	}
+
+	loggerId, err := parse.LoggerID(d.Get("api_management_logger_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).ApiManagement.LoggerClient
+	logger, err := client.Get(ctx, loggerId.ResourceGroup, loggerId.ServiceName, loggerId.Name)
+	if err != nil {
+		// the Logger may be being (re-)created in this apply
+		if utils.ResponseWasNotFound(logger.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *loggerId, err)
+	}
+
+	if props := logger.LoggerContractProperties; props != nil {
+		identifier := d.Get("identifier").(string)
+		expected := apimanagement.ApplicationInsights
+		if identifier == "azuremonitor" {
+			expected = apimanagement.AzureMonitor
+		}
+
+		if props.LoggerType != expected {
+			return fmt.Errorf("`api_management_logger_id` must reference a Logger of type %q when `identifier` is %q but %s is of type %q", string(expected), identifier, *loggerId, string(props.LoggerType))
+		}
+	}
+
+	return nil
+}
+
 func resourceApiManagementDiagnosticCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).ApiManagement.DiagnosticClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -150,14 +238,23 @@ func resourceApiManagementDiagnosticCreateUpdate(d *pluginsdk.ResourceData, meta
 
 	parameters := apimanagement.DiagnosticContract{
 		DiagnosticContractProperties: &apimanagement.DiagnosticContractProperties{
-			LoggerID:            utils.String(d.Get("api_management_logger_id").(string)),
-			OperationNameFormat: apimanagement.OperationNameFormat(d.Get("operation_name_format").(string)),
+			LoggerID: utils.String(d.Get("api_management_logger_id").(string)),
 		},
 	}
 
-	if samplingPercentage, ok := d.GetOk("sampling_percentage"); ok {
+	// the Operation Name Format is only supported for Application Insights, where it defaults to `Name`
+	if diagnosticId == "applicationinsights" {
+		operationNameFormat := string(apimanagement.Name)
+		if v := d.Get("operation_name_format").(string); v != "" {
+			operationNameFormat = v
+		}
+		parameters.OperationNameFormat = apimanagement.OperationNameFormat(operationNameFormat)
+	}
+
+	// `0` is a valid percentage, so we need to check whether this has been set rather than whether it's non-zero
+	if samplingPercentage, ok := d.GetOkExists("sampling_percentage"); ok { //nolint:SA1019
 		parameters.Sampling = &apimanagement.SamplingSettings{
-			SamplingType: apimanagement.Fixed,
+			SamplingType: apimanagement.SamplingType(d.Get("sampling_type").(string)),
 			Percentage:   utils.Float(samplingPercentage.(float64)),
 		}
 	} else {
@@ -246,9 +343,19 @@ func resourceApiManagementDiagnosticRead(d *pluginsdk.ResourceData, meta interfa
 	d.Set("api_management_name", diagnosticId.ServiceName)
 	d.Set("api_management_logger_id", resp.LoggerID)
 	if props := resp.DiagnosticContractProperties; props != nil {
+		// when Sampling isn't configured the API logs all requests, so the effective percentage is 100
+		samplingPercentage := 100.0
 		if props.Sampling != nil && props.Sampling.Percentage != nil {
-			d.Set("sampling_percentage", props.Sampling.Percentage)
+			samplingPercentage = *props.Sampling.Percentage
+		}
+		d.Set("sampling_percentage", samplingPercentage)
+
+		samplingType := string(apimanagement.Fixed)
+		if props.Sampling != nil && props.Sampling.SamplingType != "" {
+			samplingType = string(props.Sampling.SamplingType)
 		}
+		d.Set("sampling_type", samplingType)
+
 		d.Set("always_log_errors", props.AlwaysLog == apimanagement.AllErrors)
 		d.Set("verbosity", props.Verbosity)
 		d.Set("log_client_ip", props.LogClientIP)
@@ -267,13 +374,18 @@ func resourceApiManagementDiagnosticRead(d *pluginsdk.ResourceData, meta interfa
 			d.Set("backend_request", nil)
 			d.Set("backend_response", nil)
 		}
-		format := string(apimanagement.Name)
-		if props.OperationNameFormat != "" {
+		// the API returns an empty value for Diagnostics created before `operation_name_format` was available, which
+		// is set as-is and suppressed against `Name` in the diff
+		format := ""
+		if diagnosticId.Name == "applicationinsights" {
 			format = string(props.OperationNameFormat)
 		}
 		d.Set("operation_name_format", format)
 	}
 
+	// this isn't returned from the API, so default this when it's not set (e.g. during import)
+	d.Set("require_body_masking", d.Get("require_body_masking").(bool))
+
 	return nil
 }
 
@@ -3,6 +3,7 @@ package apimanagement_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -105,6 +106,148 @@ func TestAccApiManagementDiagnostic_completeUpdate(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementDiagnostic_dataMasking(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataMasking(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementDiagnostic_operationNameFormatDefault(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("operation_name_format").HasValue("Name"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:   r.operationNameFormat(data, "Name"),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccApiManagementDiagnostic_operationNameFormatAzureMonitor(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.operationNameFormatAzureMonitor(data),
+			ExpectError: regexp.MustCompile("`operation_name_format` can only be specified when `identifier` is `applicationinsights`"),
+		},
+	})
+}
+
+func TestAccApiManagementDiagnostic_loggerTypeMismatch(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			// the Logger needs to exist prior to the plan for it's type to be checked
+			Config: r.template(data),
+		},
+		{
+			Config:      r.loggerTypeMismatch(data),
+			ExpectError: regexp.MustCompile("`api_management_logger_id` must reference a Logger of type \"azureMonitor\" when `identifier` is \"azuremonitor\""),
+		},
+	})
+}
+
+func TestAccApiManagementDiagnostic_samplingPercentage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sampling_percentage").HasValue("100"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.samplingPercentage(data, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sampling_percentage").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.samplingPercentage(data, 50),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sampling_percentage").HasValue("50"),
+				check.That(data.ResourceName).Key("sampling_type").HasValue("fixed"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementDiagnostic_additionalContentPerStage(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.additionalContentPerStage(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("frontend_request.0.body_bytes").HasValue("8192"),
+				check.That(data.ResourceName).Key("frontend_request.0.headers_to_log.#").HasValue("0"),
+				check.That(data.ResourceName).Key("backend_response.0.body_bytes").HasValue("0"),
+				check.That(data.ResourceName).Key("backend_response.0.headers_to_log.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementDiagnostic_requireBodyMasking(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_diagnostic", "test")
+	r := ApiManagementDiagnosticResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.requireBodyMasking(data, 32),
+			ExpectError: regexp.MustCompile("`backend_response.0.body_bytes` must be `0` when `require_body_masking` is enabled"),
+		},
+		{
+			Config: r.requireBodyMasking(data, 0),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("require_body_masking").HasValue("true"),
+			),
+		},
+		data.ImportStep("require_body_masking"),
+	})
+}
+
 func (ApiManagementDiagnosticResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	diagnosticId, err := parse.DiagnosticID(state.ID)
 	if err != nil {
@@ -271,3 +414,158 @@ resource "azurerm_api_management_diagnostic" "test" {
 }
 `, r.template(data))
 }
+
+func (r ApiManagementDiagnosticResource) dataMasking(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+
+  frontend_request {
+    body_bytes     = 100
+    headers_to_log = ["Accept"]
+    data_masking {
+      headers {
+        mode  = "Mask"
+        value = "frontend-Request-Header"
+      }
+    }
+  }
+
+  frontend_response {
+    body_bytes     = 1000
+    headers_to_log = ["Content-Length"]
+    data_masking {
+      query_params {
+        mode  = "Hide"
+        value = "frontend-Response-Test"
+      }
+    }
+  }
+
+  backend_request {
+    body_bytes     = 1
+    headers_to_log = ["Host", "Content-Encoding"]
+    data_masking {
+      query_params {
+        mode  = "Hide"
+        value = "backend-Request-Test"
+      }
+      headers {
+        mode  = "Mask"
+        value = "backend-Request-Header"
+      }
+    }
+  }
+
+  backend_response {
+    body_bytes     = 10
+    headers_to_log = ["Content-Type"]
+    data_masking {
+      query_params {
+        mode  = "Mask"
+        value = "backend-Resp-Test"
+      }
+    }
+  }
+}
+`, r.template(data))
+}
+
+func (r ApiManagementDiagnosticResource) operationNameFormat(data acceptance.TestData, format string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+  operation_name_format    = %q
+}
+`, r.template(data), format)
+}
+
+func (r ApiManagementDiagnosticResource) operationNameFormatAzureMonitor(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "azuremonitor"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+  operation_name_format    = "Url"
+}
+`, r.template(data))
+}
+
+func (r ApiManagementDiagnosticResource) loggerTypeMismatch(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "azuremonitor"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+}
+`, r.template(data))
+}
+
+func (r ApiManagementDiagnosticResource) samplingPercentage(data acceptance.TestData, percentage float64) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+  sampling_percentage      = %f
+}
+`, r.template(data), percentage)
+}
+
+func (r ApiManagementDiagnosticResource) additionalContentPerStage(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+
+  frontend_request {
+    body_bytes = 8192
+  }
+
+  backend_response {
+    headers_to_log = ["Content-Type", "Content-Length"]
+  }
+}
+`, r.template(data))
+}
+
+func (r ApiManagementDiagnosticResource) requireBodyMasking(data acceptance.TestData, bodyBytes int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_diagnostic" "test" {
+  identifier               = "applicationinsights"
+  resource_group_name      = azurerm_resource_group.test.name
+  api_management_name      = azurerm_api_management.test.name
+  api_management_logger_id = azurerm_api_management_logger.test.id
+  require_body_masking     = true
+
+  backend_response {
+    body_bytes = %d
+  }
+}
+`, r.template(data), bodyBytes)
+}
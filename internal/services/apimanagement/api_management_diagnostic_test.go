@@ -0,0 +1,53 @@
+package apimanagement
+
+import (
+	"testing"
+)
+
+func TestApiManagementDiagnosticOperationNameFormatIsEquivalent(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "Empty API value and Name",
+			Old:      "",
+			New:      "Name",
+			Expected: true,
+		},
+		{
+			Name:     "Empty API value and Url",
+			Old:      "",
+			New:      "Url",
+			Expected: false,
+		},
+		{
+			Name:     "Name and Name",
+			Old:      "Name",
+			New:      "Name",
+			Expected: true,
+		},
+		{
+			Name:     "Name and Url",
+			Old:      "Name",
+			New:      "Url",
+			Expected: false,
+		},
+		{
+			Name:     "Url and Name",
+			Old:      "Url",
+			New:      "Name",
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := apiManagementDiagnosticOperationNameFormatIsEquivalent(v.Old, v.New); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
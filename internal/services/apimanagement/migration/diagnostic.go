@@ -0,0 +1,156 @@
+package migration
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ pluginsdk.StateUpgrade = DiagnosticV0ToV1{}
+
+type DiagnosticV0ToV1 struct{}
+
+func (DiagnosticV0ToV1) Schema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"identifier": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"resource_group_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"api_management_name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"api_management_logger_id": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
+		"sampling_percentage": {
+			Type:     pluginsdk.TypeFloat,
+			Optional: true,
+			Computed: true,
+		},
+
+		"always_log_errors": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"verbosity": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"log_client_ip": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
+
+		"http_correlation_protocol": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+
+		"frontend_request": diagnosticV0AdditionalContentSchema(),
+
+		"frontend_response": diagnosticV0AdditionalContentSchema(),
+
+		"backend_request": diagnosticV0AdditionalContentSchema(),
+
+		"backend_response": diagnosticV0AdditionalContentSchema(),
+
+		"operation_name_format": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  "Name",
+		},
+	}
+}
+
+func (DiagnosticV0ToV1) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		// `enabled` has been removed from the API and is no longer part of the schema
+		log.Printf("[DEBUG] Removing `enabled` from the state of API Management Diagnostic %q", rawState["id"])
+		delete(rawState, "enabled")
+
+		return rawState, nil
+	}
+}
+
+// diagnosticV0AdditionalContentSchema is a copy of the `frontend_*`/`backend_*` schema at Schema Version 0, which was
+// shared with `azurerm_api_management_api_diagnostic` and so already included `data_masking`
+func diagnosticV0AdditionalContentSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		MaxItems: 1,
+		Optional: true,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"body_bytes": {
+					Type:     pluginsdk.TypeInt,
+					Optional: true,
+				},
+				"headers_to_log": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+					Set: pluginsdk.HashString,
+				},
+				"data_masking": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &pluginsdk.Resource{
+						Schema: map[string]*pluginsdk.Schema{
+							"query_params": diagnosticV0DataMaskingEntityListSchema(),
+							"headers":      diagnosticV0DataMaskingEntityListSchema(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func diagnosticV0DataMaskingEntityListSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"mode": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+
+				"value": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
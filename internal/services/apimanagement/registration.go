@@ -24,6 +24,7 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_api_management":                 dataSourceApiManagementService(),
 		"azurerm_api_management_api":             dataSourceApiManagementApi(),
 		"azurerm_api_management_api_version_set": dataSourceApiManagementApiVersionSet(),
+		"azurerm_api_management_diagnostic":      dataSourceApiManagementDiagnostic(),
 		"azurerm_api_management_gateway":         dataSourceApiManagementGateway(),
 		"azurerm_api_management_group":           dataSourceApiManagementGroup(),
 		"azurerm_api_management_product":         dataSourceApiManagementProduct(),
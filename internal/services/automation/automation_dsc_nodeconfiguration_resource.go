@@ -1,8 +1,12 @@
 package automation
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"log"
+	"regexp"
 	"strings"
 	"time"
 
@@ -12,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -40,6 +45,8 @@ func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
+				// the API may return the name in a different casing to the one it was created with
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"automation_account_name": {
@@ -54,19 +61,85 @@ func resourceAutomationDscNodeConfiguration() *pluginsdk.Resource {
 			"content_embedded": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validate.DscNodeConfigurationContent,
+			},
+
+			"source_content_hash": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-fA-F0-9]{64}$`), "`source_content_hash` must be a SHA256 hash"),
+			},
+
+			"incremental": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				// the API may return the name in a different casing to the one it was created with
+				DiffSuppressFunc: suppress.CaseDifference,
+			},
+
+			"content_hash": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"created_time": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"last_modified_time": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(automationDscNodeConfigurationContentHashCustomizeDiff),
+			pluginsdk.CustomizeDiffShim(automationDscNodeConfigurationLastModifiedTimeCustomizeDiff),
+		),
 	}
 }
 
+func automationDscNodeConfigurationContentHashCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the API doesn't return the content, so the hash of the content is stored to be able to reliably surface changes to it
+	if content := d.Get("content_embedded").(string); content != "" && d.Get("content_hash").(string) != automationDscNodeConfigurationContentHash(content) {
+		return d.SetNew("content_hash", automationDscNodeConfigurationContentHash(content))
+	}
+
+	return nil
+}
+
+func automationDscNodeConfigurationLastModifiedTimeCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// any update to the DSC Node Configuration changes the last modified time, which is only known once it's been applied
+	if d.Id() == "" {
+		return nil
+	}
+
+	for _, key := range []string{"content_hash", "source_content_hash", "incremental", "configuration_name"} {
+		if d.HasChange(key) {
+			return d.SetNewComputed("last_modified_time")
+		}
+	}
+
+	return nil
+}
+
+func automationDscNodeConfigurationContentHash(content string) string {
+	hash := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(hash[:])
+}
+
 func resourceAutomationDscNodeConfigurationCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Automation.DscNodeConfigurationClient
+	configurationClient := meta.(*clients.Client).Automation.DscConfigurationClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -91,10 +164,21 @@ func resourceAutomationDscNodeConfigurationCreateUpdate(d *pluginsdk.ResourceDat
 
 	content := d.Get("content_embedded").(string)
 
-	// configuration name is always the first part of the dsc node configuration
+	// unless otherwise specified, the configuration name is the first part of the dsc node configuration
 	// e.g. webserver.prod or webserver.local will be associated to the dsc configuration webserver
-
 	configurationName := strings.Split(name, ".")[0]
+	if v, ok := d.GetOk("configuration_name"); ok {
+		configurationName = v.(string)
+	}
+
+	// this is checked during apply rather than plan, since the DSC Configuration is commonly provisioned in the same apply
+	configuration, err := configurationClient.Get(ctx, resGroup, accName, configurationName)
+	if err != nil {
+		if utils.ResponseWasNotFound(configuration.Response) {
+			return fmt.Errorf("the DSC Configuration %q was not found in Automation Account %q (Resource Group %q) - please ensure either `configuration_name` or the first segment of `name` refers to an existing DSC Configuration", configurationName, accName, resGroup)
+		}
+		return fmt.Errorf("retrieving DSC Configuration %q (Automation Account %q / Resource Group %q): %+v", configurationName, accName, resGroup, err)
+	}
 
 	parameters := automation.DscNodeConfigurationCreateOrUpdateParameters{
 		DscNodeConfigurationCreateOrUpdateParametersProperties: &automation.DscNodeConfigurationCreateOrUpdateParametersProperties{
@@ -105,10 +189,21 @@ func resourceAutomationDscNodeConfigurationCreateUpdate(d *pluginsdk.ResourceDat
 			Configuration: &automation.DscConfigurationAssociationProperty{
 				Name: utils.String(configurationName),
 			},
+			IncrementNodeConfigurationBuild: utils.Bool(d.Get("incremental").(bool)),
 		},
 		Name: utils.String(name),
 	}
 
+	if sourceContentHash := d.Get("source_content_hash").(string); sourceContentHash != "" {
+		// the API expects the hash in upper case, whereas `filesha256` & `sha256` return it in lower case
+		parameters.DscNodeConfigurationCreateOrUpdateParametersProperties.Source.Hash = &automation.ContentHash{
+			Algorithm: utils.String("sha256"),
+			Value:     utils.String(strings.ToUpper(sourceContentHash)),
+		}
+	}
+
+	// TODO: guard against concurrent updates using `If-Match` once the API exposes an ETag for DSC Node Configurations,
+	// neither the `DscNodeConfiguration` returned from the API nor `CreateOrUpdate` support one at this API Version
 	if _, err := client.CreateOrUpdate(ctx, resGroup, accName, name, parameters); err != nil {
 		return err
 	}
@@ -123,6 +218,7 @@ func resourceAutomationDscNodeConfigurationCreateUpdate(d *pluginsdk.ResourceDat
 	}
 
 	d.SetId(*read.ID)
+	d.Set("content_hash", automationDscNodeConfigurationContentHash(content))
 
 	return resourceAutomationDscNodeConfigurationRead(d, meta)
 }
@@ -155,7 +251,27 @@ func resourceAutomationDscNodeConfigurationRead(d *pluginsdk.ResourceData, meta
 	d.Set("automation_account_name", accName)
 	d.Set("configuration_name", resp.Configuration.Name)
 
-	// cannot read back content_embedded as not part of body nor exposed through method
+	incremental := false
+	if props := resp.DscNodeConfigurationProperties; props != nil && props.IncrementNodeConfigurationBuild != nil {
+		incremental = *props.IncrementNodeConfigurationBuild
+	}
+	d.Set("incremental", incremental)
+
+	createdTime := ""
+	lastModifiedTime := ""
+	if props := resp.DscNodeConfigurationProperties; props != nil {
+		if props.CreationTime != nil {
+			createdTime = props.CreationTime.Format(time.RFC3339)
+		}
+		if props.LastModifiedTime != nil {
+			lastModifiedTime = props.LastModifiedTime.Format(time.RFC3339)
+		}
+	}
+	d.Set("created_time", createdTime)
+	d.Set("last_modified_time", lastModifiedTime)
+
+	// cannot read back content_embedded as not part of body nor exposed through method - nor is a digest of the
+	// content available, so `content_hash` is only ever set from the content which was submitted
 
 	return nil
 }
@@ -3,6 +3,7 @@ package automation_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -26,9 +27,12 @@ func TestAccAutomationDscNodeConfiguration_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("configuration_name").HasValue("acctest"),
+				check.That(data.ResourceName).Key("content_hash").Exists(),
+				check.That(data.ResourceName).Key("created_time").Exists(),
+				check.That(data.ResourceName).Key("last_modified_time").Exists(),
 			),
 		},
-		data.ImportStep("content_embedded"),
+		data.ImportStep("content_embedded", "content_hash"),
 	})
 }
 
@@ -47,6 +51,69 @@ func TestAccAutomationDscNodeConfiguration_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAutomationDscNodeConfiguration_mixedCaseName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_dsc_nodeconfiguration", "test")
+	r := AutomationDscNodeConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mixedCaseName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("content_embedded", "content_hash"),
+		{
+			Config:   r.mixedCaseName(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccAutomationDscNodeConfiguration_incremental(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_dsc_nodeconfiguration", "test")
+	r := AutomationDscNodeConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.incremental(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("incremental").HasValue("true"),
+			),
+		},
+		data.ImportStep("content_embedded", "content_hash", "source_content_hash"),
+	})
+}
+
+func TestAccAutomationDscNodeConfiguration_explicitConfigurationName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_dsc_nodeconfiguration", "test")
+	r := AutomationDscNodeConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.explicitConfigurationName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("configuration_name").HasValue("acctest"),
+			),
+		},
+		data.ImportStep("content_embedded", "content_hash"),
+	})
+}
+
+func TestAccAutomationDscNodeConfiguration_configurationNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_dsc_nodeconfiguration", "test")
+	r := AutomationDscNodeConfigurationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.withName(data, "acctest", "missing.localhost"),
+			ExpectError: regexp.MustCompile("the DSC Configuration \"missing\" was not found"),
+		},
+	})
+}
+
 func (t AutomationDscNodeConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -64,7 +131,32 @@ func (t AutomationDscNodeConfigurationResource) Exists(ctx context.Context, clie
 	return utils.Bool(resp.DscNodeConfigurationProperties != nil), nil
 }
 
-func (AutomationDscNodeConfigurationResource) basic(data acceptance.TestData) string {
+func (r AutomationDscNodeConfigurationResource) basic(data acceptance.TestData) string {
+	return r.withName(data, "acctest", "acctest.localhost")
+}
+
+func (r AutomationDscNodeConfigurationResource) mixedCaseName(data acceptance.TestData) string {
+	return r.withName(data, "AccTest", "AccTest.LocalHost")
+}
+
+func (r AutomationDscNodeConfigurationResource) incremental(data acceptance.TestData) string {
+	return r.withNameAndProperties(data, "acctest", "acctest.localhost", `
+  incremental         = true
+  source_content_hash = sha256(local.content)
+`)
+}
+
+func (r AutomationDscNodeConfigurationResource) explicitConfigurationName(data acceptance.TestData) string {
+	return r.withNameAndProperties(data, "acctest", "webserver.localhost", `
+  configuration_name = azurerm_automation_dsc_configuration.test.name
+`)
+}
+
+func (r AutomationDscNodeConfigurationResource) withName(data acceptance.TestData, configurationName, name string) string {
+	return r.withNameAndProperties(data, configurationName, name, "")
+}
+
+func (AutomationDscNodeConfigurationResource) withNameAndProperties(data acceptance.TestData, configurationName, name, properties string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -83,20 +175,15 @@ resource "azurerm_automation_account" "test" {
 }
 
 resource "azurerm_automation_dsc_configuration" "test" {
-  name                    = "acctest"
+  name                    = "%s"
   resource_group_name     = azurerm_resource_group.test.name
   automation_account_name = azurerm_automation_account.test.name
   location                = azurerm_resource_group.test.location
   content_embedded        = "configuration acctest {}"
 }
 
-resource "azurerm_automation_dsc_nodeconfiguration" "test" {
-  name                    = "acctest.localhost"
-  resource_group_name     = azurerm_resource_group.test.name
-  automation_account_name = azurerm_automation_account.test.name
-  depends_on              = [azurerm_automation_dsc_configuration.test]
-
-  content_embedded = <<mofcontent
+locals {
+  content = <<mofcontent
 instance of MSFT_FileDirectoryConfiguration as $MSFT_FileDirectoryConfiguration1ref
 {
   TargetResourceID = "[File]bla";
@@ -119,9 +206,18 @@ instance of OMI_ConfigurationDocument
   Name="acctest";
 };
 mofcontent
+}
+
+resource "azurerm_automation_dsc_nodeconfiguration" "test" {
+  name                    = "%s"
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  depends_on              = [azurerm_automation_dsc_configuration.test]
 
+  content_embedded = local.content
+%s
 }
-`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, configurationName, name, properties)
 }
 
 func (AutomationDscNodeConfigurationResource) requiresImport(data acceptance.TestData) string {
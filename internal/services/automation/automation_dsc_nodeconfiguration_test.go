@@ -0,0 +1,66 @@
+package automation
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestAutomationDscNodeConfigurationConfigurationName(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    automation.DscNodeConfiguration
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    automation.DscNodeConfiguration{},
+			Expected: "",
+		},
+		{
+			Name: "From the Name",
+			Input: automation.DscNodeConfiguration{
+				Name: utils.String("webserver.prod"),
+			},
+			Expected: "webserver",
+		},
+		{
+			Name: "Name without a Segment",
+			Input: automation.DscNodeConfiguration{
+				Name: utils.String("webserver"),
+			},
+			Expected: "webserver",
+		},
+		{
+			Name: "From the Configuration",
+			Input: automation.DscNodeConfiguration{
+				Name: utils.String("webserver.prod"),
+				DscNodeConfigurationProperties: &automation.DscNodeConfigurationProperties{
+					Configuration: &automation.DscConfigurationAssociationProperty{
+						Name: utils.String("acctest"),
+					},
+				},
+			},
+			Expected: "acctest",
+		},
+		{
+			Name: "Empty Configuration",
+			Input: automation.DscNodeConfiguration{
+				Name: utils.String("webserver.prod"),
+				DscNodeConfigurationProperties: &automation.DscNodeConfigurationProperties{
+					Configuration: &automation.DscConfigurationAssociationProperty{},
+				},
+			},
+			Expected: "webserver",
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := automationDscNodeConfigurationConfigurationName(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
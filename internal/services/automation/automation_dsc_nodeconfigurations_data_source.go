@@ -0,0 +1,115 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceAutomationDscNodeConfigurations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAutomationDscNodeConfigurationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"configuration_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"node_configurations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAutomationDscNodeConfigurationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.DscNodeConfigurationClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+	configurationName := d.Get("configuration_name").(string)
+
+	nodeConfigurations := make([]interface{}, 0)
+	for iterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, "", nil, nil, ""); iterator.NotDone(); err = iterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("listing DSC Node Configurations for Automation Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		}
+
+		nodeConfiguration := iterator.Value()
+		if nodeConfiguration.ID == nil || nodeConfiguration.Name == nil {
+			continue
+		}
+		if !strings.EqualFold(automationDscNodeConfigurationConfigurationName(nodeConfiguration), configurationName) {
+			continue
+		}
+
+		nodeConfigurations = append(nodeConfigurations, map[string]interface{}{
+			"id":   *nodeConfiguration.ID,
+			"name": *nodeConfiguration.Name,
+		})
+	}
+
+	accountId := parse.NewAutomationAccountID(subscriptionId, resourceGroup, accountName)
+	d.SetId(fmt.Sprintf("%s/configurations/%s", accountId.ID(), configurationName))
+
+	if err := d.Set("node_configurations", nodeConfigurations); err != nil {
+		return fmt.Errorf("setting `node_configurations`: %+v", err)
+	}
+
+	return nil
+}
+
+// automationDscNodeConfigurationConfigurationName returns the name of the DSC Configuration the DSC Node Configuration
+// was compiled from - falling back to the first segment of the name (e.g. `webserver` for `webserver.prod`) when the
+// API doesn't return the associated DSC Configuration
+func automationDscNodeConfigurationConfigurationName(input automation.DscNodeConfiguration) string {
+	if props := input.DscNodeConfigurationProperties; props != nil && props.Configuration != nil && props.Configuration.Name != nil && *props.Configuration.Name != "" {
+		return *props.Configuration.Name
+	}
+
+	if input.Name == nil {
+		return ""
+	}
+
+	return strings.Split(*input.Name, ".")[0]
+}
@@ -0,0 +1,66 @@
+package automation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AutomationDscNodeConfigurationsDataSource struct {
+}
+
+func TestAccDataSourceAutomationDscNodeConfigurations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_dsc_nodeconfigurations", "test")
+	r := AutomationDscNodeConfigurationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("node_configurations.#").HasValue("1"),
+				check.That(data.ResourceName).Key("node_configurations.0.name").HasValue("acctest.localhost"),
+				check.That(data.ResourceName).Key("node_configurations.0.id").MatchesOtherKey(check.That("azurerm_automation_dsc_nodeconfiguration.test").Key("id")),
+			),
+		},
+	})
+}
+
+func TestAccDataSourceAutomationDscNodeConfigurations_noMatches(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_dsc_nodeconfigurations", "test")
+	r := AutomationDscNodeConfigurationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.noMatches(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("node_configurations.#").HasValue("0"),
+			),
+		},
+	})
+}
+
+func (AutomationDscNodeConfigurationsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_dsc_nodeconfigurations" "test" {
+  resource_group_name     = azurerm_automation_dsc_nodeconfiguration.test.resource_group_name
+  automation_account_name = azurerm_automation_dsc_nodeconfiguration.test.automation_account_name
+  configuration_name      = azurerm_automation_dsc_nodeconfiguration.test.configuration_name
+}
+`, AutomationDscNodeConfigurationResource{}.basic(data))
+}
+
+func (AutomationDscNodeConfigurationsDataSource) noMatches(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_dsc_nodeconfigurations" "test" {
+  resource_group_name     = azurerm_automation_dsc_nodeconfiguration.test.resource_group_name
+  automation_account_name = azurerm_automation_dsc_nodeconfiguration.test.automation_account_name
+  configuration_name      = "webserver"
+}
+`, AutomationDscNodeConfigurationResource{}.basic(data))
+}
@@ -0,0 +1,123 @@
+package automation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/helper"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceAutomationJobSchedule() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceAutomationJobScheduleRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"automation_account_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.AutomationAccount(),
+			},
+
+			"runbook_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.RunbookName(),
+			},
+
+			"schedule_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ScheduleName(),
+			},
+
+			"job_schedule_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"parameters": {
+				Type:     pluginsdk.TypeMap,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"run_on": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAutomationJobScheduleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Automation.JobScheduleClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	accountName := d.Get("automation_account_name").(string)
+	runbookName := d.Get("runbook_name").(string)
+	scheduleName := d.Get("schedule_name").(string)
+
+	// the Job Schedule ID is generated, so the Job Schedule has to be found by the Runbook and Schedule it links
+	var jobScheduleId *string
+	for jsIterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, ""); jsIterator.NotDone(); err = jsIterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("listing Job Schedules for Automation Account %q (Resource Group %q): %+v", accountName, resourceGroup, err)
+		}
+
+		props := jsIterator.Value().JobScheduleProperties
+		if props == nil || props.Runbook == nil || props.Runbook.Name == nil || props.Schedule == nil || props.Schedule.Name == nil {
+			continue
+		}
+
+		if strings.EqualFold(*props.Runbook.Name, runbookName) && strings.EqualFold(*props.Schedule.Name, scheduleName) {
+			jobScheduleId = props.JobScheduleID
+			break
+		}
+	}
+
+	if jobScheduleId == nil || *jobScheduleId == "" {
+		return fmt.Errorf("a Job Schedule for Runbook %q and Schedule %q was not found in Automation Account %q (Resource Group %q)", runbookName, scheduleName, accountName, resourceGroup)
+	}
+
+	jobScheduleUUID, err := uuid.FromString(*jobScheduleId)
+	if err != nil {
+		return fmt.Errorf("parsing Job Schedule ID %q: %+v", *jobScheduleId, err)
+	}
+
+	// the List API isn't guaranteed to return the full set of properties, so retrieve the Job Schedule itself
+	resp, err := client.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
+	if err != nil {
+		return fmt.Errorf("retrieving Job Schedule %q (Automation Account %q / Resource Group %q): %+v", jobScheduleUUID, accountName, resourceGroup, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("retrieving Job Schedule %q (Automation Account %q / Resource Group %q): `id` was nil", jobScheduleUUID, accountName, resourceGroup)
+	}
+	d.SetId(*resp.ID)
+
+	d.Set("job_schedule_id", jobScheduleUUID.String())
+
+	if props := resp.JobScheduleProperties; props != nil {
+		d.Set("run_on", props.RunOn)
+		d.Set("parameters", helper.FlattenAutomationJobScheduleParameters(props.Parameters, map[string]interface{}{}))
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package automation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type AutomationJobScheduleDataSource struct {
+}
+
+func TestAccDataSourceAutomationJobSchedule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_automation_job_schedule", "test")
+	r := AutomationJobScheduleDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("job_schedule_id").MatchesOtherKey(check.That("azurerm_automation_job_schedule.test").Key("job_schedule_id")),
+				check.That(data.ResourceName).Key("parameters.%").HasValue("2"),
+				check.That(data.ResourceName).Key("parameters.output").HasValue("Earth"),
+				check.That(data.ResourceName).Key("parameters.keepcount").HasValue("20"),
+			),
+		},
+	})
+}
+
+func (AutomationJobScheduleDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = azurerm_automation_job_schedule.test.resource_group_name
+  automation_account_name = azurerm_automation_job_schedule.test.automation_account_name
+  runbook_name            = azurerm_automation_job_schedule.test.runbook_name
+  schedule_name           = azurerm_automation_job_schedule.test.schedule_name
+}
+`, AutomationJobScheduleResource{}.mixedCaseParameters(data))
+}
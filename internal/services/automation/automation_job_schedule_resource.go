@@ -1,16 +1,21 @@
 package automation
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/gofrs/uuid"
+	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/helper"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -24,8 +29,10 @@ func resourceAutomationJobSchedule() *pluginsdk.Resource {
 		Read:   resourceAutomationJobScheduleRead,
 		Delete: resourceAutomationJobScheduleDelete,
 
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, _, err := parseAutomationJobScheduleID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -66,7 +73,13 @@ func resourceAutomationJobSchedule() *pluginsdk.Resource {
 				Elem: &pluginsdk.Schema{
 					Type: pluginsdk.TypeString,
 				},
-				ValidateFunc: validate.ParameterNames,
+				ValidateFunc: validate.ParameterNamesUnique,
+				// the parameter names are returned in lowercase, so when there's no prior state to take the casing
+				// from (e.g. following an import) a difference in only the casing mustn't force a new resource
+				DiffSuppressFunc: func(_, _, _ string, d *pluginsdk.ResourceData) bool {
+					old, new := d.GetChange("parameters")
+					return automationJobScheduleParametersAreEquivalent(old.(map[string]interface{}), new.(map[string]interface{}))
+				},
 			},
 
 			"run_on": {
@@ -87,6 +100,7 @@ func resourceAutomationJobSchedule() *pluginsdk.Resource {
 
 func resourceAutomationJobScheduleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Automation.JobScheduleClient
+	runbookClient := meta.(*clients.Client).Automation.RunbookClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -115,31 +129,51 @@ func resourceAutomationJobScheduleCreate(d *pluginsdk.ResourceData, meta interfa
 		}
 
 		if existing.ID != nil && *existing.ID != "" {
+			// a user-supplied Job Schedule ID which is already used for another Runbook/Schedule is a collision
+			// rather than something which can be imported, so surface that explicitly
+			if _, ok := d.GetOk("job_schedule_id"); ok {
+				if err := checkAutomationJobScheduleIdNotInUse(existing, runbookName, scheduleName); err != nil {
+					return fmt.Errorf("validating `job_schedule_id` %q (Account %q / Resource Group %q): %+v", jobScheduleUUID, accountName, resourceGroup, err)
+				}
+			}
+
 			return tf.ImportAsExistsError("azurerm_automation_job_schedule", *existing.ID)
 		}
 	}
 
+	// this is checked during apply rather than plan, since the Runbook is commonly provisioned in the same apply -
+	// but needs to happen prior to removing any existing Job Schedules below
+	runbook, err := runbookClient.Get(ctx, resourceGroup, accountName, runbookName)
+	if err != nil {
+		if utils.ResponseWasNotFound(runbook.Response) {
+			return fmt.Errorf("the Runbook %q was not found in Automation Account %q (Resource Group %q) - please ensure `runbook_name` refers to an existing Runbook", runbookName, accountName, resourceGroup)
+		}
+		return fmt.Errorf("retrieving Runbook %q (Automation Account %q / Resource Group %q): %+v", runbookName, accountName, resourceGroup, err)
+	}
+
 	// fix issue: https://github.com/hashicorp/terraform-provider-azurerm/issues/7130
 	// When the runbook has some updates, it'll update all related job schedule id, so the elder job schedule will not exist
 	// We need to delete the job schedule id if exists to recreate the job schedule
+	// the List API doesn't document which fields can be used in the `$filter`, so every Job Schedule in the Automation
+	// Account is listed and filtered client-side - only those for this Runbook are retained
+	jobSchedules := make([]automation.JobSchedule, 0)
 	for jsIterator, err := client.ListByAutomationAccountComplete(ctx, resourceGroup, accountName, ""); jsIterator.NotDone(); err = jsIterator.NextWithContext(ctx) {
 		if err != nil {
 			return fmt.Errorf("loading Automation Account %q Job Schedule List: %+v", accountName, err)
 		}
-		if props := jsIterator.Value().JobScheduleProperties; props != nil {
-			if props.Schedule.Name != nil && *props.Schedule.Name == scheduleName && props.Runbook.Name != nil && *props.Runbook.Name == runbookName {
-				if jsIterator.Value().JobScheduleID == nil || *jsIterator.Value().JobScheduleID == "" {
-					return fmt.Errorf("job schedule Id is nil or empty listed by Automation Account %q Job Schedule List: %+v", accountName, err)
-				}
-				jsId, err := uuid.FromString(*jsIterator.Value().JobScheduleID)
-				if err != nil {
-					return fmt.Errorf("parsing job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
-				}
-				if _, err := client.Delete(ctx, resourceGroup, accountName, jsId); err != nil {
-					return fmt.Errorf("deleting job schedule Id listed by Automation Account %q Job Schedule List:%v", accountName, err)
-				}
-			}
+
+		jobSchedule := jsIterator.Value()
+		if props := jobSchedule.JobScheduleProperties; props == nil || props.Runbook == nil || props.Runbook.Name == nil || *props.Runbook.Name != runbookName {
+			continue
 		}
+		jobSchedules = append(jobSchedules, jobSchedule)
+	}
+
+	deleteFunc := func(ctx context.Context, jobScheduleId uuid.UUID) (autorest.Response, error) {
+		return client.Delete(ctx, resourceGroup, accountName, jobScheduleId)
+	}
+	if err := removeAutomationJobSchedulesForRunbook(ctx, jobSchedules, runbookName, scheduleName, deleteFunc); err != nil {
+		return fmt.Errorf("removing existing Job Schedules for Runbook %q and Schedule %q (Automation Account %q / Resource Group %q): %+v", runbookName, scheduleName, accountName, resourceGroup, err)
 	}
 
 	parameters := automation.JobScheduleCreateParameters{
@@ -156,12 +190,7 @@ func resourceAutomationJobScheduleCreate(d *pluginsdk.ResourceData, meta interfa
 
 	// parameters to be passed into the runbook
 	if v, ok := d.GetOk("parameters"); ok {
-		jsParameters := make(map[string]*string)
-		for k, v := range v.(map[string]interface{}) {
-			value := v.(string)
-			jsParameters[k] = &value
-		}
-		properties.Parameters = jsParameters
+		properties.Parameters = helper.ExpandAutomationJobScheduleParameters(v.(map[string]interface{}))
 	}
 
 	if v, ok := d.GetOk("run_on"); ok {
@@ -192,15 +221,13 @@ func resourceAutomationJobScheduleRead(d *pluginsdk.ResourceData, meta interface
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, jobScheduleUUID, err := parseAutomationJobScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	jobScheduleID := id.Path["jobSchedules"]
-	jobScheduleUUID := uuid.FromStringOrNil(jobScheduleID)
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName := id.AutomationAccountName
 
 	resp, err := client.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
 	if err != nil {
@@ -223,11 +250,7 @@ func resourceAutomationJobScheduleRead(d *pluginsdk.ResourceData, meta interface
 	}
 
 	if v := resp.JobScheduleProperties.Parameters; v != nil {
-		jsParameters := make(map[string]interface{})
-		for key, value := range v {
-			jsParameters[strings.ToLower(key)] = value
-		}
-		d.Set("parameters", jsParameters)
+		d.Set("parameters", helper.FlattenAutomationJobScheduleParameters(v, d.Get("parameters").(map[string]interface{})))
 	}
 
 	return nil
@@ -238,15 +261,13 @@ func resourceAutomationJobScheduleDelete(d *pluginsdk.ResourceData, meta interfa
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, jobScheduleUUID, err := parseAutomationJobScheduleID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	jobScheduleID := id.Path["jobSchedules"]
-	jobScheduleUUID := uuid.FromStringOrNil(jobScheduleID)
 	resourceGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	accountName := id.AutomationAccountName
 
 	resp, err := client.Delete(ctx, resourceGroup, accountName, jobScheduleUUID)
 	if err != nil {
@@ -257,3 +278,100 @@ func resourceAutomationJobScheduleDelete(d *pluginsdk.ResourceData, meta interfa
 
 	return nil
 }
+
+// automationJobScheduleParametersAreEquivalent returns whether the parameters are the same when the parameter names
+// are compared case-insensitively, since these are sent to (and returned from) Azure in lowercase
+func automationJobScheduleParametersAreEquivalent(old, new map[string]interface{}) bool {
+	if len(old) != len(new) {
+		return false
+	}
+
+	normalized := make(map[string]interface{})
+	for k, v := range old {
+		normalized[strings.ToLower(k)] = v
+	}
+
+	for k, v := range new {
+		if existing, ok := normalized[strings.ToLower(k)]; !ok || existing != v {
+			return false
+		}
+	}
+
+	return true
+}
+
+// removeAutomationJobSchedulesForRunbook removes any of the Job Schedules linking the specified Runbook and Schedule.
+// Job Schedules which have already been removed are skipped, and any other errors are returned together once
+// every matching Job Schedule has been attempted - so that a single failure doesn't leave the remainder in place
+func removeAutomationJobSchedulesForRunbook(ctx context.Context, jobSchedules []automation.JobSchedule, runbookName, scheduleName string, deleteFunc func(ctx context.Context, jobScheduleId uuid.UUID) (autorest.Response, error)) error {
+	var errs *multierror.Error
+
+	for _, jobSchedule := range jobSchedules {
+		props := jobSchedule.JobScheduleProperties
+		if props == nil || props.Schedule == nil || props.Runbook == nil {
+			continue
+		}
+		if props.Schedule.Name == nil || *props.Schedule.Name != scheduleName || props.Runbook.Name == nil || *props.Runbook.Name != runbookName {
+			continue
+		}
+
+		if props.JobScheduleID == nil || *props.JobScheduleID == "" {
+			errs = multierror.Append(errs, fmt.Errorf("a Job Schedule was returned without a Job Schedule ID"))
+			continue
+		}
+
+		jobScheduleId, err := uuid.FromString(*props.JobScheduleID)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("parsing Job Schedule ID %q: %+v", *props.JobScheduleID, err))
+			continue
+		}
+
+		resp, err := deleteFunc(ctx, jobScheduleId)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp) {
+				log.Printf("[DEBUG] Job Schedule %q was already removed - skipping", jobScheduleId)
+				continue
+			}
+
+			errs = multierror.Append(errs, fmt.Errorf("deleting Job Schedule %q: %+v", jobScheduleId, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// checkAutomationJobScheduleIdNotInUse returns an error when the existing Job Schedule is associated with a different
+// Runbook or Schedule than the one being created
+func checkAutomationJobScheduleIdNotInUse(existing automation.JobSchedule, runbookName, scheduleName string) error {
+	existingRunbookName := ""
+	existingScheduleName := ""
+	if props := existing.JobScheduleProperties; props != nil {
+		if props.Runbook != nil && props.Runbook.Name != nil {
+			existingRunbookName = *props.Runbook.Name
+		}
+		if props.Schedule != nil && props.Schedule.Name != nil {
+			existingScheduleName = *props.Schedule.Name
+		}
+	}
+
+	if !strings.EqualFold(existingRunbookName, runbookName) || !strings.EqualFold(existingScheduleName, scheduleName) {
+		return fmt.Errorf("the Job Schedule ID is already in use by the Job Schedule for Runbook %q and Schedule %q", existingRunbookName, existingScheduleName)
+	}
+
+	return nil
+}
+
+// parseAutomationJobScheduleID parses the Job Schedule ID, additionally validating that the name of the Job Schedule is a UUID
+func parseAutomationJobScheduleID(input string) (*parse.JobScheduleId, uuid.UUID, error) {
+	id, err := parse.JobScheduleID(input)
+	if err != nil {
+		return nil, uuid.Nil, err
+	}
+
+	jobScheduleUUID, err := uuid.FromString(id.Name)
+	if err != nil {
+		return nil, uuid.Nil, fmt.Errorf("parsing Job Schedule ID %q: expected the `jobSchedules` segment to be a UUID but got %q: %+v", input, id.Name, err)
+	}
+
+	return id, jobScheduleUUID, nil
+}
@@ -3,13 +3,14 @@ package automation_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/gofrs/uuid"
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -92,20 +93,75 @@ func TestAccAutomationJobSchedule_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAutomationJobSchedule_importInvalidId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_schedule", "test")
+	r := AutomationJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:  data.ResourceName,
+			ImportState:   true,
+			ImportStateId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Automation/automationAccounts/account1/jobSchedules/schedule1",
+			ExpectError:   regexp.MustCompile("expected the `jobSchedules` segment to be a UUID"),
+		},
+	})
+}
+
+func TestAccAutomationJobSchedule_mixedCaseParameters(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_schedule", "test")
+	r := AutomationJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.mixedCaseParameters(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("parameters.Output").HasValue("Earth"),
+				check.That(data.ResourceName).Key("parameters.KeepCount").HasValue("20"),
+			),
+		},
+		{
+			Config:   r.mixedCaseParameters(data),
+			PlanOnly: true,
+		},
+		// the parameter names are returned in lowercase, so can't be verified on import - a difference in only the
+		// casing of the names is suppressed, so this doesn't cause the imported Job Schedule to be re-created
+		data.ImportStep("parameters"),
+	})
+}
+
+func TestAccAutomationJobSchedule_runbookNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_automation_job_schedule", "test")
+	r := AutomationJobScheduleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.runbookNotFound(data),
+			ExpectError: regexp.MustCompile("the Runbook \"Output-Missing\" was not found"),
+		},
+	})
+}
+
 func (t AutomationJobScheduleResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := azure.ParseAzureResourceID(state.ID)
+	id, err := parse.JobScheduleID(state.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	jobScheduleID := id.Path["jobSchedules"]
-	jobScheduleUUID := uuid.FromStringOrNil(jobScheduleID)
-	resourceGroup := id.ResourceGroup
-	accountName := id.Path["automationAccounts"]
+	jobScheduleUUID, err := uuid.FromString(id.Name)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, err := clients.Automation.JobScheduleClient.Get(ctx, resourceGroup, accountName, jobScheduleUUID)
+	resp, err := clients.Automation.JobScheduleClient.Get(ctx, id.ResourceGroup, id.AutomationAccountName, jobScheduleUUID)
 	if err != nil {
-		return nil, fmt.Errorf("retrieving Automation Job Schedule '%s' (Account %q / Resource Group %q) does not exist", jobScheduleUUID, accountName, resourceGroup)
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	return utils.Bool(resp.JobScheduleProperties != nil), nil
@@ -203,6 +259,37 @@ resource "azurerm_automation_job_schedule" "test" {
 `, AutomationJobScheduleResource{}.template(data))
 }
 
+func (AutomationJobScheduleResource) mixedCaseParameters(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  schedule_name           = azurerm_automation_schedule.test.name
+  runbook_name            = azurerm_automation_runbook.test.name
+
+  parameters = {
+    Output    = "Earth"
+    KeepCount = 20
+  }
+}
+`, AutomationJobScheduleResource{}.template(data))
+}
+
+func (AutomationJobScheduleResource) runbookNotFound(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_automation_job_schedule" "test" {
+  resource_group_name     = azurerm_resource_group.test.name
+  automation_account_name = azurerm_automation_account.test.name
+  schedule_name           = azurerm_automation_schedule.test.name
+  runbook_name            = "Output-Missing"
+}
+`, AutomationJobScheduleResource{}.template(data))
+}
+
 func (AutomationJobScheduleResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
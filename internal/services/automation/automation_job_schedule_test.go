@@ -0,0 +1,243 @@
+package automation
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/gofrs/uuid"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestRemoveAutomationJobSchedulesForRunbook(t *testing.T) {
+	jobSchedule := func(id, runbookName, scheduleName string) automation.JobSchedule {
+		return automation.JobSchedule{
+			JobScheduleProperties: &automation.JobScheduleProperties{
+				JobScheduleID: utils.String(id),
+				Runbook: &automation.RunbookAssociationProperty{
+					Name: utils.String(runbookName),
+				},
+				Schedule: &automation.ScheduleAssociationProperty{
+					Name: utils.String(scheduleName),
+				},
+			},
+		}
+	}
+	respondWith := func(statusCode int, err error) (autorest.Response, error) {
+		return autorest.Response{Response: &http.Response{StatusCode: statusCode}}, err
+	}
+
+	first := "00000000-0000-0000-0000-000000000001"
+	second := "00000000-0000-0000-0000-000000000002"
+	other := "00000000-0000-0000-0000-000000000003"
+
+	cases := []struct {
+		Name          string
+		JobSchedules  []automation.JobSchedule
+		Responses     map[string]int
+		ExpectDeleted []string
+		ExpectError   bool
+	}{
+		{
+			Name:          "No Job Schedules",
+			JobSchedules:  []automation.JobSchedule{},
+			ExpectDeleted: []string{},
+		},
+		{
+			Name: "Only matching Job Schedules are deleted",
+			JobSchedules: []automation.JobSchedule{
+				jobSchedule(first, "runbook1", "schedule1"),
+				jobSchedule(other, "runbook2", "schedule1"),
+			},
+			ExpectDeleted: []string{first},
+		},
+		{
+			Name: "Already deleted Job Schedule is skipped",
+			JobSchedules: []automation.JobSchedule{
+				jobSchedule(first, "runbook1", "schedule1"),
+				jobSchedule(second, "runbook1", "schedule1"),
+			},
+			Responses: map[string]int{
+				first: http.StatusNotFound,
+			},
+			ExpectDeleted: []string{first, second},
+		},
+		{
+			Name: "Failure doesn't prevent the remaining Job Schedules being deleted",
+			JobSchedules: []automation.JobSchedule{
+				jobSchedule(first, "runbook1", "schedule1"),
+				jobSchedule(second, "runbook1", "schedule1"),
+			},
+			Responses: map[string]int{
+				first: http.StatusInternalServerError,
+			},
+			ExpectDeleted: []string{first, second},
+			ExpectError:   true,
+		},
+		{
+			Name: "Non-matching Job Schedules are left in place",
+			JobSchedules: []automation.JobSchedule{
+				jobSchedule(other, "runbook2", "schedule2"),
+			},
+			Responses: map[string]int{
+				other: http.StatusInternalServerError,
+			},
+			ExpectDeleted: []string{},
+		},
+		{
+			Name: "Invalid Job Schedule ID",
+			JobSchedules: []automation.JobSchedule{
+				jobSchedule("not-a-uuid", "runbook1", "schedule1"),
+				jobSchedule(second, "runbook1", "schedule1"),
+			},
+			ExpectDeleted: []string{second},
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		deleted := make([]string, 0)
+		deleteFunc := func(ctx context.Context, jobScheduleId uuid.UUID) (autorest.Response, error) {
+			id := jobScheduleId.String()
+			deleted = append(deleted, id)
+
+			if statusCode, ok := v.Responses[id]; ok {
+				return respondWith(statusCode, fmt.Errorf("unexpected status %d", statusCode))
+			}
+			return respondWith(http.StatusOK, nil)
+		}
+
+		err := removeAutomationJobSchedulesForRunbook(context.TODO(), v.JobSchedules, "runbook1", "schedule1", deleteFunc)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if len(deleted) != len(v.ExpectDeleted) {
+			t.Fatalf("Expected %d Job Schedules to be deleted but got %d: %+v", len(v.ExpectDeleted), len(deleted), deleted)
+		}
+		for i, id := range v.ExpectDeleted {
+			if deleted[i] != id {
+				t.Fatalf("Expected Job Schedule %q to be deleted but got %q", id, deleted[i])
+			}
+		}
+	}
+}
+
+func TestCheckAutomationJobScheduleIdNotInUse(t *testing.T) {
+	jobSchedule := func(runbookName, scheduleName string) automation.JobSchedule {
+		return automation.JobSchedule{
+			JobScheduleProperties: &automation.JobScheduleProperties{
+				Runbook: &automation.RunbookAssociationProperty{
+					Name: utils.String(runbookName),
+				},
+				Schedule: &automation.ScheduleAssociationProperty{
+					Name: utils.String(scheduleName),
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name        string
+		Existing    automation.JobSchedule
+		ExpectError bool
+	}{
+		{
+			Name:     "Same Runbook and Schedule",
+			Existing: jobSchedule("runbook1", "schedule1"),
+		},
+		{
+			Name:     "Same Runbook and Schedule in a different casing",
+			Existing: jobSchedule("Runbook1", "SCHEDULE1"),
+		},
+		{
+			Name:        "Different Runbook",
+			Existing:    jobSchedule("runbook2", "schedule1"),
+			ExpectError: true,
+		},
+		{
+			Name:        "Different Schedule",
+			Existing:    jobSchedule("runbook1", "schedule2"),
+			ExpectError: true,
+		},
+		{
+			Name:        "No Properties",
+			Existing:    automation.JobSchedule{},
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := checkAutomationJobScheduleIdNotInUse(v.Existing, "runbook1", "schedule1")
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
+
+func TestAutomationJobScheduleParametersAreEquivalent(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      map[string]interface{}
+		New      map[string]interface{}
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Old:      map[string]interface{}{},
+			New:      map[string]interface{}{},
+			Expected: true,
+		},
+		{
+			Name:     "Same",
+			Old:      map[string]interface{}{"output": "hello"},
+			New:      map[string]interface{}{"output": "hello"},
+			Expected: true,
+		},
+		{
+			Name:     "Imported Lowercase Names",
+			Old:      map[string]interface{}{"outputmessage": "hello", "type": "Test"},
+			New:      map[string]interface{}{"OutputMessage": "hello", "Type": "Test"},
+			Expected: true,
+		},
+		{
+			Name:     "Different Value Casing",
+			Old:      map[string]interface{}{"outputmessage": "hello"},
+			New:      map[string]interface{}{"OutputMessage": "Hello"},
+			Expected: false,
+		},
+		{
+			Name:     "Different Names",
+			Old:      map[string]interface{}{"output": "hello"},
+			New:      map[string]interface{}{"message": "hello"},
+			Expected: false,
+		},
+		{
+			Name:     "Added Parameter",
+			Old:      map[string]interface{}{"output": "hello"},
+			New:      map[string]interface{}{"Output": "hello", "Type": "Test"},
+			Expected: false,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := automationJobScheduleParametersAreEquivalent(v.Old, v.New); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
@@ -334,7 +334,7 @@ func resourceAutomationRunbookRead(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	jobSchedule := helper.FlattenAutomationJobSchedule(jsMap)
+	jobSchedule := helper.FlattenAutomationJobSchedule(jsMap, d.Get("job_schedule").(*pluginsdk.Set).List())
 	if err := d.Set("job_schedule", jobSchedule); err != nil {
 		return fmt.Errorf("setting `job_schedule`: %+v", err)
 	}
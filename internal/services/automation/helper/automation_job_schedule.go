@@ -3,6 +3,7 @@ package helper
 import (
 	"bytes"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/automation/mgmt/2018-06-30-preview/automation"
 	"github.com/gofrs/uuid"
@@ -31,7 +32,7 @@ func JobScheduleSchema() *pluginsdk.Schema {
 					Elem: &pluginsdk.Schema{
 						Type: pluginsdk.TypeString,
 					},
-					ValidateFunc: validate.ParameterNames,
+					ValidateFunc: validate.ParameterNamesUnique,
 				},
 
 				"run_on": {
@@ -69,12 +70,7 @@ func ExpandAutomationJobSchedule(input []interface{}, runBookName string) (*map[
 		}
 
 		if v, ok := js["parameters"]; ok {
-			jsParameters := make(map[string]*string)
-			for k, v := range v.(map[string]interface{}) {
-				value := v.(string)
-				jsParameters[k] = &value
-			}
-			jobScheduleCreateParameters.JobScheduleCreateProperties.Parameters = jsParameters
+			jobScheduleCreateParameters.JobScheduleCreateProperties.Parameters = ExpandAutomationJobScheduleParameters(v.(map[string]interface{}))
 		}
 
 		if v, ok := js["run_on"]; ok && v.(string) != "" {
@@ -91,7 +87,7 @@ func ExpandAutomationJobSchedule(input []interface{}, runBookName string) (*map[
 	return &res, nil
 }
 
-func FlattenAutomationJobSchedule(jsMap map[uuid.UUID]automation.JobScheduleProperties) *pluginsdk.Set {
+func FlattenAutomationJobSchedule(jsMap map[uuid.UUID]automation.JobScheduleProperties, existing []interface{}) *pluginsdk.Set {
 	res := &pluginsdk.Set{
 		F: resourceAutomationJobScheduleHash,
 	}
@@ -105,9 +101,21 @@ func FlattenAutomationJobSchedule(jsMap map[uuid.UUID]automation.JobScheduleProp
 			runOn = *js.RunOn
 		}
 
+		// the casing of the parameter names is taken from the Job Schedule for the same Schedule in the config
+		existingParameters := make(map[string]interface{})
+		for _, raw := range existing {
+			v, ok := raw.(map[string]interface{})
+			if !ok || !strings.EqualFold(v["schedule_name"].(string), scheduleName) {
+				continue
+			}
+			if parameters, ok := v["parameters"].(map[string]interface{}); ok {
+				existingParameters = parameters
+			}
+		}
+
 		res.Add(map[string]interface{}{
 			"schedule_name":   scheduleName,
-			"parameters":      utils.FlattenMapStringPtrString(js.Parameters),
+			"parameters":      FlattenAutomationJobScheduleParameters(js.Parameters, existingParameters),
 			"run_on":          runOn,
 			"job_schedule_id": jsId.String(),
 		})
@@ -116,6 +124,40 @@ func FlattenAutomationJobSchedule(jsMap map[uuid.UUID]automation.JobScheduleProp
 	return res
 }
 
+func ExpandAutomationJobScheduleParameters(input map[string]interface{}) map[string]*string {
+	// due to a bug in the implementation of Runbooks in Azure, the parameter names need to be sent in lowercase
+	// see: https://github.com/Azure/azure-sdk-for-go/issues/4780
+	output := make(map[string]*string)
+	for k, v := range input {
+		value := v.(string)
+		output[strings.ToLower(k)] = &value
+	}
+	return output
+}
+
+func FlattenAutomationJobScheduleParameters(input map[string]*string, existing map[string]interface{}) map[string]interface{} {
+	// the parameter names are returned in lowercase, so where possible the casing from the config is used
+	names := make(map[string]string)
+	for k := range existing {
+		names[strings.ToLower(k)] = k
+	}
+
+	output := make(map[string]interface{})
+	for k, v := range input {
+		key := strings.ToLower(k)
+		if name, ok := names[key]; ok {
+			key = name
+		}
+
+		value := ""
+		if v != nil {
+			value = *v
+		}
+		output[key] = value
+	}
+	return output
+}
+
 func resourceAutomationJobScheduleHash(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -21,11 +21,13 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_automation_account":           dataSourceAutomationAccount(),
-		"azurerm_automation_variable_bool":     dataSourceAutomationVariableBool(),
-		"azurerm_automation_variable_datetime": dataSourceAutomationVariableDateTime(),
-		"azurerm_automation_variable_int":      dataSourceAutomationVariableInt(),
-		"azurerm_automation_variable_string":   dataSourceAutomationVariableString(),
+		"azurerm_automation_account":                dataSourceAutomationAccount(),
+		"azurerm_automation_dsc_nodeconfigurations": dataSourceAutomationDscNodeConfigurations(),
+		"azurerm_automation_job_schedule":           dataSourceAutomationJobSchedule(),
+		"azurerm_automation_variable_bool":          dataSourceAutomationVariableBool(),
+		"azurerm_automation_variable_datetime":      dataSourceAutomationVariableDateTime(),
+		"azurerm_automation_variable_int":           dataSourceAutomationVariableInt(),
+		"azurerm_automation_variable_string":        dataSourceAutomationVariableString(),
 	}
 }
 
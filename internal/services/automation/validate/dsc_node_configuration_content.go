@@ -0,0 +1,107 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var dscNodeConfigurationInstanceRegex = regexp.MustCompile(`(?i)\binstance\s+of\s+\S+`)
+
+// DscNodeConfigurationContent performs a lightweight check that the content of a DSC Node Configuration is
+// a MOF document - it contains at least one `instance of` declaration and the braces are balanced (ignoring
+// any braces within strings or comments). The content is compiled by Azure, so this intentionally doesn't
+// attempt to validate the MOF any further.
+func DscNodeConfigurationContent(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if !dscNodeConfigurationInstanceRegex.MatchString(value) {
+		errors = append(errors, fmt.Errorf("expected %q to be a MOF document containing at least one `instance of` declaration", k))
+	}
+
+	if err := validateMofBracesAreBalanced(value); err != nil {
+		errors = append(errors, fmt.Errorf("expected %q to be a valid MOF document: %+v", k, err))
+	}
+
+	return warnings, errors
+}
+
+func validateMofBracesAreBalanced(input string) error {
+	depth := 0
+	line := 1
+	inString := false
+	inLineComment := false
+	inBlockComment := false
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		next := byte(0)
+		if i+1 < len(input) {
+			next = input[i+1]
+		}
+
+		if c == '\n' {
+			line++
+			inLineComment = false
+			continue
+		}
+
+		switch {
+		case inLineComment:
+			continue
+
+		case inBlockComment:
+			if c == '*' && next == '/' {
+				inBlockComment = false
+				i++
+			}
+			continue
+
+		case inString:
+			if c == '\\' {
+				// skip the escaped character
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+
+		switch c {
+		case '"':
+			inString = true
+		case '/':
+			if next == '/' {
+				inLineComment = true
+				i++
+			} else if next == '*' {
+				inBlockComment = true
+				i++
+			}
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth < 0 {
+				return fmt.Errorf("unexpected `}` on line %d", line)
+			}
+		}
+	}
+
+	if inString {
+		return fmt.Errorf("unterminated string")
+	}
+
+	if inBlockComment {
+		return fmt.Errorf("unterminated comment")
+	}
+
+	if depth != 0 {
+		return fmt.Errorf("%d unclosed `{`", depth)
+	}
+
+	return nil
+}
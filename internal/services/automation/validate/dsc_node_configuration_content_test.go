@@ -0,0 +1,105 @@
+package validate
+
+import "testing"
+
+func TestDscNodeConfigurationContent(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    string
+		Expected bool
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Name:     "Not MOF",
+			Input:    "configuration acctest {}",
+			Expected: false,
+		},
+		{
+			Name: "Single Instance",
+			Input: `instance of OMI_ConfigurationDocument
+{
+  Version="2.0.0";
+  Name="acctest";
+};`,
+			Expected: true,
+		},
+		{
+			Name: "Multiple Instances",
+			Input: `instance of MSFT_FileDirectoryConfiguration as $MSFT_FileDirectoryConfiguration1ref
+{
+  DestinationPath = "c:\\bogus.txt";
+  ModuleName = "PSDesiredStateConfiguration";
+};
+instance of OMI_ConfigurationDocument
+{
+  CompatibleVersionAdditionalProperties= {"Omi_BaseResource:ConfigurationName"};
+  Name="acctest";
+};`,
+			Expected: true,
+		},
+		{
+			Name: "Braces Within Strings And Comments",
+			Input: `/*
+@TargetNode='localhost' {
+*/
+// another comment }
+instance of OMI_ConfigurationDocument
+{
+  Contents = "{ \"escaped\": } }";
+};`,
+			Expected: true,
+		},
+		{
+			Name: "Uppercase Instance",
+			Input: `INSTANCE OF OMI_ConfigurationDocument
+{
+};`,
+			Expected: true,
+		},
+		{
+			Name: "Unclosed Brace",
+			Input: `instance of OMI_ConfigurationDocument
+{
+  Name="acctest";
+`,
+			Expected: false,
+		},
+		{
+			Name: "Unexpected Closing Brace",
+			Input: `instance of OMI_ConfigurationDocument
+{
+  Name="acctest";
+}};`,
+			Expected: false,
+		},
+		{
+			Name: "Unterminated String",
+			Input: `instance of OMI_ConfigurationDocument
+{
+  Name="acctest;
+};`,
+			Expected: false,
+		},
+		{
+			Name: "Unterminated Comment",
+			Input: `/* instance of OMI_ConfigurationDocument
+{
+};`,
+			Expected: false,
+		},
+	}
+
+	for _, v := range testCases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		_, errors := DscNodeConfigurationContent(v.Input, "content_embedded")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (%+v)", v.Expected, result, errors)
+		}
+	}
+}
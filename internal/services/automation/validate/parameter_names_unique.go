@@ -0,0 +1,24 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParameterNamesUnique validates that the parameter names are unique when compared case-insensitively, since
+// the parameter names are sent to Azure in lowercase (see helper.ExpandAutomationJobScheduleParameters)
+func ParameterNamesUnique(v interface{}, k string) (warnings []string, errors []error) {
+	m := v.(map[string]interface{})
+
+	names := make(map[string]string)
+	for name := range m {
+		normalized := strings.ToLower(name)
+		if existing, ok := names[normalized]; ok {
+			errors = append(errors, fmt.Errorf("the parameter names in %q must be unique when compared case-insensitively, but %q and %q were specified", k, existing, name))
+			continue
+		}
+		names[normalized] = name
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,44 @@
+package validate
+
+import "testing"
+
+func TestParameterNamesUnique(t *testing.T) {
+	testCases := []struct {
+		Input    map[string]interface{}
+		Expected bool
+	}{
+		{
+			Input:    map[string]interface{}{},
+			Expected: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"output": "World",
+				"case":   "Original",
+			},
+			Expected: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"Output":     "World",
+				"KeepCount":  "10",
+				"WebhookUri": "https://example.com/hook",
+			},
+			Expected: true,
+		},
+		{
+			Input: map[string]interface{}{
+				"Output": "World",
+				"output": "Earth",
+			},
+			Expected: false,
+		},
+	}
+	for _, v := range testCases {
+		_, errors := ParameterNamesUnique(v.Input, "parameters")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package compute
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+)
+
+// dedicatedHostHealthState returns the Health State of the Dedicated Host from it's Instance View (e.g. `available`),
+// or an empty string when this isn't available
+func dedicatedHostHealthState(instanceView *compute.DedicatedHostInstanceView) string {
+	if instanceView == nil || instanceView.Statuses == nil {
+		return ""
+	}
+
+	for _, status := range *instanceView.Statuses {
+		if status.Code == nil {
+			continue
+		}
+
+		// could also be the provisioning state which is exposed separately
+		state := strings.ToLower(*status.Code)
+		if !strings.HasPrefix(state, "healthstate/") {
+			continue
+		}
+
+		return strings.TrimPrefix(state, "healthstate/")
+	}
+
+	return ""
+}
+
+// dedicatedHostIsReplacing returns whether the Dedicated Host is currently being replaced by the platform
+// following a failure, during which time it can't be updated
+func dedicatedHostIsReplacing(props *compute.DedicatedHostProperties) bool {
+	if props == nil {
+		return false
+	}
+
+	if props.ProvisioningState != nil && strings.EqualFold(*props.ProvisioningState, "Replacing") {
+		return true
+	}
+
+	return strings.EqualFold(dedicatedHostHealthState(props.InstanceView), "replacing")
+}
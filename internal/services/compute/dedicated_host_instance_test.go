@@ -0,0 +1,111 @@
+package compute
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestDedicatedHostHealthState(t *testing.T) {
+	buildInstanceView := func(statuses ...string) *compute.DedicatedHostInstanceView {
+		results := make([]compute.InstanceViewStatus, 0)
+
+		for _, v := range statuses {
+			results = append(results, compute.InstanceViewStatus{
+				Code: utils.String(v),
+			})
+		}
+
+		return &compute.DedicatedHostInstanceView{
+			Statuses: &results,
+		}
+	}
+
+	testCases := []struct {
+		Name     string
+		Input    *compute.DedicatedHostInstanceView
+		Expected string
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: "",
+		},
+		{
+			Name:     "No Health State",
+			Input:    buildInstanceView("ProvisioningState/succeeded"),
+			Expected: "",
+		},
+		{
+			Name:     "Available",
+			Input:    buildInstanceView("ProvisioningState/succeeded", "HealthState/available"),
+			Expected: "available",
+		},
+		{
+			Name:     "Degraded",
+			Input:    buildInstanceView("ProvisioningState/succeeded", "HealthState/Degraded"),
+			Expected: "degraded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		result := dedicatedHostHealthState(testCase.Input)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %q but got %q", testCase.Expected, result)
+		}
+	}
+}
+
+func TestDedicatedHostIsReplacing(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Input    *compute.DedicatedHostProperties
+		Expected bool
+	}{
+		{
+			Name:     "None",
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Name: "Succeeded",
+			Input: &compute.DedicatedHostProperties{
+				ProvisioningState: utils.String("Succeeded"),
+			},
+			Expected: false,
+		},
+		{
+			Name: "Replacing Provisioning State",
+			Input: &compute.DedicatedHostProperties{
+				ProvisioningState: utils.String("Replacing"),
+			},
+			Expected: true,
+		},
+		{
+			Name: "Replacing Health State",
+			Input: &compute.DedicatedHostProperties{
+				ProvisioningState: utils.String("Succeeded"),
+				InstanceView: &compute.DedicatedHostInstanceView{
+					Statuses: &[]compute.InstanceViewStatus{
+						{
+							Code: utils.String("HealthState/replacing"),
+						},
+					},
+				},
+			},
+			Expected: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Logf("Running %q..", testCase.Name)
+
+		result := dedicatedHostIsReplacing(testCase.Input)
+		if result != testCase.Expected {
+			t.Fatalf("Expected %t but got %t", testCase.Expected, result)
+		}
+	}
+}
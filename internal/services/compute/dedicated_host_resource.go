@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
@@ -45,6 +47,8 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 				Required:     true,
 				ForceNew:     true,
 				ValidateFunc: validate.DedicatedHostName(),
+				// Dedicated Host names are case-insensitive, so an import using a differently cased ID shouldn't force a new resource
+				DiffSuppressFunc: suppress.CaseDifference,
 			},
 
 			"location": azure.SchemaLocation(),
@@ -56,40 +60,16 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 				ValidateFunc: validate.DedicatedHostGroupID,
 			},
 
-			"sku_name": {
+			"dedicated_host_group_name": {
 				Type:     pluginsdk.TypeString,
-				ForceNew: true,
-				Required: true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"DSv3-Type1",
-					"DSv3-Type2",
-					"DSv4-Type1",
-					"ESv3-Type1",
-					"ESv3-Type2",
-					"FSv2-Type2",
-					"DASv4-Type1",
-					"DCSv2-Type1",
-					"DDSv4-Type1",
-					"DSv3-Type1",
-					"DSv3-Type2",
-					"DSv3-Type3",
-					"DSv4-Type1",
-					"EASv4-Type1",
-					"EDSv4-Type1",
-					"ESv3-Type1",
-					"ESv3-Type2",
-					"ESv3-Type3",
-					"ESv4-Type1",
-					"FSv2-Type2",
-					"FSv2-Type3",
-					"LSv2-Type1",
-					"MS-Type1",
-					"MSm-Type1",
-					"MSmv2-Type1",
-					"MSv2-Type1",
-					"NVASv4-Type1",
-					"NVSv3-Type1",
-				}, false),
+				Computed: true,
+			},
+
+			"sku_name": {
+				Type:         pluginsdk.TypeString,
+				ForceNew:     true,
+				Required:     true,
+				ValidateFunc: validate.DedicatedHostSkuName(),
 			},
 
 			"platform_fault_domain": {
@@ -115,12 +95,62 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 				Default: string(compute.DedicatedHostLicenseTypesNone),
 			},
 
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"health_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(dedicatedHostGroupLocationCustomizeDiff),
+	}
+}
+
+// dedicatedHostGroupLocationCustomizeDiff ensures that a (changed) Dedicated Host Group is in the same location as the
+// Dedicated Host, since otherwise the Dedicated Host would be destroyed and then fail to be re-created
+func dedicatedHostGroupLocationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("dedicated_host_group_id") {
+		return nil
+	}
+
+	// the Dedicated Host Group (or the location) is commonly provisioned in the same apply, in which case this
+	// can only be checked by the API
+	if !d.NewValueKnown("dedicated_host_group_id") || !d.NewValueKnown("location") {
+		return nil
+	}
+
+	hostGroupId, err := parse.DedicatedHostGroupID(d.Get("dedicated_host_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	client := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
+	group, err := client.Get(ctx, hostGroupId.ResourceGroup, hostGroupId.HostGroupName, "")
+	if err != nil {
+		// the Dedicated Host Group may be being (re-)created in this apply
+		if utils.ResponseWasNotFound(group.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *hostGroupId, err)
+	}
+
+	location := azure.NormalizeLocation(d.Get("location").(string))
+	if group.Location != nil && azure.NormalizeLocation(*group.Location) != location {
+		return fmt.Errorf("the Dedicated Host must be in the same location as the Dedicated Host Group - %s is in %q but `location` is %q", *hostGroupId, azure.NormalizeLocation(*group.Location), location)
 	}
+
+	return nil
 }
 
 func resourceDedicatedHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	groupsClient := meta.(*clients.Client).Compute.DedicatedHostGroupsClient
 	client := meta.(*clients.Client).Compute.DedicatedHostsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -131,6 +161,8 @@ func resourceDedicatedHostCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// a Dedicated Host is a nested resource within a Dedicated Host Group, as such it's always created
+	// within the Resource Group of the parent Host Group (which can differ from that of the other resources)
 	resourceGroupName := dedicatedHostGroupId.ResourceGroup
 	hostGroupName := dedicatedHostGroupId.HostGroupName
 
@@ -146,12 +178,24 @@ func resourceDedicatedHostCreate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	group, err := groupsClient.Get(ctx, resourceGroupName, hostGroupName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Dedicated Host Group %q (Resource Group %q): %+v", hostGroupName, resourceGroupName, err)
+	}
+
+	platformFaultDomain := d.Get("platform_fault_domain").(int)
+	if props := group.DedicatedHostGroupProperties; props != nil && props.PlatformFaultDomainCount != nil {
+		if err := validate.DedicatedHostPlatformFaultDomain(*dedicatedHostGroupId, int(*props.PlatformFaultDomainCount), platformFaultDomain); err != nil {
+			return err
+		}
+	}
+
 	parameters := compute.DedicatedHost{
 		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
 		DedicatedHostProperties: &compute.DedicatedHostProperties{
 			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
 			LicenseType:          compute.DedicatedHostLicenseTypes(d.Get("license_type").(string)),
-			PlatformFaultDomain:  utils.Int32(int32(d.Get("platform_fault_domain").(int))),
+			PlatformFaultDomain:  utils.Int32(int32(platformFaultDomain)),
 		},
 		Sku: &compute.Sku{
 			Name: utils.String(d.Get("sku_name").(string)),
@@ -201,7 +245,7 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error retrieving Dedicated Host Group %q (Resource Group %q): %+v", id.HostGroupName, id.ResourceGroup, err)
 	}
 
-	resp, err := hostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, "")
+	resp, err := hostsClient.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, compute.InstanceView)
 	if err != nil {
 		if utils.ResponseWasNotFound(resp.Response) {
 			log.Printf("[INFO] Dedicated Host %q does not exist - removing from state", d.Id())
@@ -214,13 +258,19 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	d.Set("name", resp.Name)
 	d.Set("dedicated_host_group_id", group.ID)
+	d.Set("dedicated_host_group_name", id.HostGroupName)
 
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 	d.Set("sku_name", resp.Sku.Name)
 	if props := resp.DedicatedHostProperties; props != nil {
-		d.Set("auto_replace_on_failure", props.AutoReplaceOnFailure)
+		// the API defaults this to `true` when it's omitted
+		autoReplaceOnFailure := true
+		if props.AutoReplaceOnFailure != nil {
+			autoReplaceOnFailure = *props.AutoReplaceOnFailure
+		}
+		d.Set("auto_replace_on_failure", autoReplaceOnFailure)
 		d.Set("license_type", props.LicenseType)
 
 		platformFaultDomain := 0
@@ -228,6 +278,9 @@ func resourceDedicatedHostRead(d *pluginsdk.ResourceData, meta interface{}) erro
 			platformFaultDomain = int(*props.PlatformFaultDomain)
 		}
 		d.Set("platform_fault_domain", platformFaultDomain)
+
+		d.Set("provisioning_state", props.ProvisioningState)
+		d.Set("health_state", dedicatedHostHealthState(props.InstanceView))
 	}
 
 	return tags.FlattenAndSet(d, resp.Tags)
@@ -243,6 +296,14 @@ func resourceDedicatedHostUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	existing, err := client.Get(ctx, id.ResourceGroup, id.HostGroupName, id.HostName, compute.InstanceView)
+	if err != nil {
+		return fmt.Errorf("retrieving Dedicated Host %q (Host Group Name %q / Resource Group %q): %+v", id.HostName, id.HostGroupName, id.ResourceGroup, err)
+	}
+	if dedicatedHostIsReplacing(existing.DedicatedHostProperties) {
+		return fmt.Errorf("Dedicated Host %q (Host Group Name %q / Resource Group %q) is currently being replaced following a failure - please retry once the replacement has completed", id.HostName, id.HostGroupName, id.ResourceGroup)
+	}
+
 	parameters := compute.DedicatedHostUpdate{
 		DedicatedHostProperties: &compute.DedicatedHostProperties{
 			AutoReplaceOnFailure: utils.Bool(d.Get("auto_replace_on_failure").(bool)),
@@ -285,13 +346,15 @@ func resourceDedicatedHostDelete(d *pluginsdk.ResourceData, meta interface{}) er
 
 	// API has bug, which appears to be eventually consistent. Tracked by this issue: https://github.com/Azure/azure-rest-api-specs/issues/8137
 	log.Printf("[DEBUG] Waiting for Dedicated Host %q (Host Group Name %q / Resource Group %q) to disappear", id.HostName, id.HostGroupName, id.ResourceGroup)
+	timeout := d.Timeout(pluginsdk.TimeoutDelete)
+	minTimeout := 10 * time.Second
 	stateConf := &pluginsdk.StateChangeConf{
-		Pending:                   []string{"Exists"},
+		Pending:                   []string{"Exists", "Retrying"},
 		Target:                    []string{"NotFound"},
 		Refresh:                   dedicatedHostDeletedRefreshFunc(ctx, client, id),
-		MinTimeout:                10 * time.Second,
-		ContinuousTargetOccurence: 20,
-		Timeout:                   d.Timeout(pluginsdk.TimeoutDelete),
+		MinTimeout:                minTimeout,
+		ContinuousTargetOccurence: dedicatedHostDeletedContinuousTargetOccurence(timeout, minTimeout),
+		Timeout:                   timeout,
 	}
 
 	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
@@ -309,9 +372,29 @@ func dedicatedHostDeletedRefreshFunc(ctx context.Context, client *compute.Dedica
 				return "NotFound", "NotFound", nil
 			}
 
-			return nil, "", fmt.Errorf("Error polling to check if the Dedicated Host has been deleted: %+v", err)
+			// transient failures (e.g. throttling or a 5xx from the API) shouldn't abort the destroy, so keep polling
+			if r := res.Response.Response; r != nil && (r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= http.StatusInternalServerError) {
+				log.Printf("[DEBUG] Retrying after transient error (Status Code %d) polling Dedicated Host %q (Host Group Name %q / Resource Group %q): %+v", r.StatusCode, id.HostName, id.HostGroupName, id.ResourceGroup, err)
+				return "Retrying", "Retrying", nil
+			}
+
+			return nil, "", fmt.Errorf("Error polling to check if the Dedicated Host %q (Host Group Name %q / Resource Group %q) has been deleted: %+v", id.HostName, id.HostGroupName, id.ResourceGroup, err)
 		}
 
 		return res, "Exists", nil
 	}
 }
+
+// dedicatedHostDeletedContinuousTargetOccurence returns the number of consecutive 404's required before the
+// Dedicated Host is considered deleted - this defaults to 20 but is scaled down to fit within half of the
+// delete timeout, so that shorter timeouts can still complete
+func dedicatedHostDeletedContinuousTargetOccurence(timeout time.Duration, minTimeout time.Duration) int {
+	occurences := 20
+	if max := int(timeout / (2 * minTimeout)); max < occurences {
+		occurences = max
+	}
+	if occurences < 1 {
+		occurences = 1
+	}
+	return occurences
+}
@@ -3,6 +3,8 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -25,6 +27,9 @@ func TestAccDedicatedHost_basic(t *testing.T) {
 			Config: r.basic(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
+				check.That(data.ResourceName).Key("dedicated_host_group_name").HasValue(fmt.Sprintf("acctest-DHG-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("health_state").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -78,6 +83,26 @@ func TestAccDedicatedHost_autoReplaceOnFailure(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedHost_autoReplaceOnFailureDisabledImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoReplaceOnFailure(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_replace_on_failure").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:   r.autoReplaceOnFailure(data, false),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccDedicatedHost_licenseType(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
 	r := DedicatedHostResource{}
@@ -151,6 +176,56 @@ func TestAccDedicatedHost_update(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedHost_hostGroupInSeparateResourceGroup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hostGroupInSeparateResourceGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("id").MatchesRegex(regexp.MustCompile(fmt.Sprintf("/resourceGroups/acctestRG-compute-host-%d/", data.RandomInteger))),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDedicatedHost_platformFaultDomainOutOfRange(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.platformFaultDomain(data, 2),
+			ExpectError: regexp.MustCompile("`platform_fault_domain` must be between 0 and 1"),
+		},
+	})
+}
+
+func TestAccDedicatedHost_hostGroupInDifferentLocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the Dedicated Host Group needs to exist prior to the plan for it's location to be checked
+			Config: r.hostGroupInDifferentLocation(data, "test"),
+		},
+		{
+			Config:      r.hostGroupInDifferentLocation(data, "secondary"),
+			ExpectError: regexp.MustCompile("the Dedicated Host must be in the same location as the Dedicated Host Group"),
+		},
+	})
+}
+
 func TestAccDedicatedHost_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
 	r := DedicatedHostResource{}
@@ -166,6 +241,48 @@ func TestAccDedicatedHost_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedHost_importDifferentCasing(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName: data.ResourceName,
+			ImportState:  true,
+			ImportStateIdFunc: func(state *acceptance.State) (string, error) {
+				rs, ok := state.RootModule().Resources[data.ResourceName]
+				if !ok {
+					return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+				}
+
+				id, err := parse.DedicatedHostID(rs.Primary.ID)
+				if err != nil {
+					return "", err
+				}
+				id.HostName = strings.ToUpper(id.HostName)
+				return id.ID(), nil
+			},
+			ImportStateCheck: func(states []*acceptance.InstanceState) error {
+				if len(states) != 1 {
+					return fmt.Errorf("expected 1 imported resource but got %d", len(states))
+				}
+
+				expected := fmt.Sprintf("acctest-DH-%d", data.RandomInteger)
+				if name := states[0].Attributes["name"]; !strings.EqualFold(name, expected) {
+					return fmt.Errorf("expected `name` to match %q case-insensitively but got %q", expected, name)
+				}
+				return nil
+			},
+		},
+	})
+}
+
 func (t DedicatedHostResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DedicatedHostID(state.ID)
 	if err != nil {
@@ -254,6 +371,74 @@ resource "azurerm_dedicated_host" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r DedicatedHostResource) hostGroupInSeparateResourceGroup(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-compute-%d"
+  location = "%s"
+}
+
+resource "azurerm_resource_group" "host" {
+  name     = "acctestRG-compute-host-%d"
+  location = "%s"
+}
+
+resource "azurerm_dedicated_host_group" "test" {
+  name                        = "acctest-DHG-%d"
+  resource_group_name         = azurerm_resource_group.host.name
+  location                    = azurerm_resource_group.host.location
+  platform_fault_domain_count = 2
+}
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctest-DH-%d"
+  location                = azurerm_resource_group.test.location
+  dedicated_host_group_id = azurerm_dedicated_host_group.test.id
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
+}
+
+func (r DedicatedHostResource) hostGroupInDifferentLocation(data acceptance.TestData, hostGroup string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host_group" "secondary" {
+  name                        = "acctest-DHG-secondary-%d"
+  resource_group_name         = azurerm_resource_group.test.name
+  location                    = "%s"
+  platform_fault_domain_count = 2
+}
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctest-DH-%d"
+  location                = azurerm_resource_group.test.location
+  dedicated_host_group_id = azurerm_dedicated_host_group.%s.id
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = 1
+}
+`, r.template(data), data.RandomInteger, data.Locations.Secondary, data.RandomInteger, hostGroup)
+}
+
+func (r DedicatedHostResource) platformFaultDomain(data acceptance.TestData, faultDomain int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctest-DH-%d"
+  location                = azurerm_resource_group.test.location
+  dedicated_host_group_id = azurerm_dedicated_host_group.test.id
+  sku_name                = "DSv3-Type1"
+  platform_fault_domain   = %d
+}
+`, r.template(data), data.RandomInteger, faultDomain)
+}
+
 func (r DedicatedHostResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,165 @@
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// dedicatedHostSkuResourceType is the Resource Type used for the SKUs of Dedicated Hosts within the Resource SKUs API
+const dedicatedHostSkuResourceType = "hostGroups/hosts"
+
+func dataSourceDedicatedHostSkus() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDedicatedHostSkusRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": azure.SchemaLocation(),
+
+			"skus": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"vm_capabilities": {
+							Type:     pluginsdk.TypeMap,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"zones": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedHostSkusRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.ResourceSkusClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	loc := location.Normalize(d.Get("location").(string))
+
+	skus := make([]compute.ResourceSku, 0)
+	filter := fmt.Sprintf("location eq '%s'", loc)
+	for iterator, err := client.ListComplete(ctx, filter); iterator.NotDone(); err = iterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("listing Resource SKUs (Location %q): %+v", loc, err)
+		}
+
+		sku := iterator.Value()
+		if sku.ResourceType == nil || !strings.EqualFold(*sku.ResourceType, dedicatedHostSkuResourceType) {
+			continue
+		}
+		skus = append(skus, sku)
+	}
+
+	d.SetId(fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Compute/locations/%s/hostSkus", subscriptionId, loc))
+
+	d.Set("location", loc)
+
+	if err := d.Set("skus", flattenDedicatedHostSkus(skus, loc)); err != nil {
+		return fmt.Errorf("setting `skus`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDedicatedHostSkus(input []compute.ResourceSku, loc string) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, sku := range input {
+		if sku.Name == nil {
+			continue
+		}
+
+		restrictedZones := make(map[string]struct{})
+		locationRestricted := false
+		if sku.Restrictions != nil {
+			for _, restriction := range *sku.Restrictions {
+				switch restriction.Type {
+				case compute.Location:
+					if restriction.Values != nil {
+						for _, v := range *restriction.Values {
+							if location.Normalize(v) == loc {
+								locationRestricted = true
+							}
+						}
+					}
+				case compute.Zone:
+					if info := restriction.RestrictionInfo; info != nil && info.Zones != nil {
+						for _, zone := range *info.Zones {
+							restrictedZones[zone] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+		// the SKU isn't available to this Subscription in this Location
+		if locationRestricted {
+			continue
+		}
+
+		capabilities := make(map[string]interface{})
+		if sku.Capabilities != nil {
+			for _, capability := range *sku.Capabilities {
+				if capability.Name == nil || capability.Value == nil {
+					continue
+				}
+				capabilities[*capability.Name] = *capability.Value
+			}
+		}
+
+		zones := make([]string, 0)
+		if sku.LocationInfo != nil {
+			for _, info := range *sku.LocationInfo {
+				if info.Location == nil || location.Normalize(*info.Location) != loc || info.Zones == nil {
+					continue
+				}
+
+				for _, zone := range *info.Zones {
+					if _, restricted := restrictedZones[zone]; !restricted {
+						zones = append(zones, zone)
+					}
+				}
+			}
+		}
+		sort.Strings(zones)
+
+		results = append(results, map[string]interface{}{
+			"name":            *sku.Name,
+			"vm_capabilities": capabilities,
+			"zones":           zones,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,39 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DedicatedHostSkusDataSource struct {
+}
+
+func TestAccDataSourceDedicatedHostSkus_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dedicated_host_skus", "test")
+	r := DedicatedHostSkusDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("skus.#").Exists(),
+				check.That(data.ResourceName).Key("skus.0.name").Exists(),
+			),
+		},
+	})
+}
+
+func (DedicatedHostSkusDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_dedicated_host_skus" "test" {
+  location = "%s"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,122 @@
+package compute
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceDedicatedHosts() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDedicatedHostsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"dedicated_host_group_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DedicatedHostGroupID,
+			},
+
+			"dedicated_hosts": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sku_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"platform_fault_domain": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDedicatedHostsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.DedicatedHostsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	hostGroupId, err := parse.DedicatedHostGroupID(d.Get("dedicated_host_group_id").(string))
+	if err != nil {
+		return err
+	}
+
+	hosts := make([]compute.DedicatedHost, 0)
+	for iterator, err := client.ListByHostGroupComplete(ctx, hostGroupId.ResourceGroup, hostGroupId.HostGroupName); iterator.NotDone(); err = iterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("listing Dedicated Hosts within %s: %+v", *hostGroupId, err)
+		}
+
+		hosts = append(hosts, iterator.Value())
+	}
+
+	d.SetId(hostGroupId.ID())
+
+	if err := d.Set("dedicated_hosts", flattenDedicatedHosts(hosts)); err != nil {
+		return fmt.Errorf("setting `dedicated_hosts`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenDedicatedHosts(input []compute.DedicatedHost) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		id := ""
+		if item.ID != nil {
+			id = *item.ID
+		}
+
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		skuName := ""
+		if item.Sku != nil && item.Sku.Name != nil {
+			skuName = *item.Sku.Name
+		}
+
+		platformFaultDomain := 0
+		if props := item.DedicatedHostProperties; props != nil && props.PlatformFaultDomain != nil {
+			platformFaultDomain = int(*props.PlatformFaultDomain)
+		}
+
+		results = append(results, map[string]interface{}{
+			"id":                    id,
+			"name":                  name,
+			"sku_name":              skuName,
+			"platform_fault_domain": platformFaultDomain,
+		})
+	}
+
+	return results
+}
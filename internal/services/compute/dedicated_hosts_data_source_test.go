@@ -0,0 +1,42 @@
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type DedicatedHostsDataSource struct {
+}
+
+func TestAccDataSourceDedicatedHosts_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_dedicated_hosts", "test")
+	r := DedicatedHostsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("dedicated_hosts.#").HasValue("1"),
+				check.That(data.ResourceName).Key("dedicated_hosts.0.id").Exists(),
+				check.That(data.ResourceName).Key("dedicated_hosts.0.name").HasValue(fmt.Sprintf("acctest-DH-%d", data.RandomInteger)),
+				check.That(data.ResourceName).Key("dedicated_hosts.0.sku_name").HasValue("DSv3-Type1"),
+				check.That(data.ResourceName).Key("dedicated_hosts.0.platform_fault_domain").HasValue("1"),
+			),
+		},
+	})
+}
+
+func (DedicatedHostsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_dedicated_hosts" "test" {
+  dedicated_host_group_id = azurerm_dedicated_host.test.dedicated_host_group_id
+
+  depends_on = [azurerm_dedicated_host.test]
+}
+`, DedicatedHostResource{}.basic(data))
+}
@@ -1,11 +1,14 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -46,6 +49,38 @@ func resourceDiskAccess() *pluginsdk.Resource {
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
+			// TODO: support `edge_zone` once the Compute SDK is updated to an API version (2021-04-01 or later)
+			// where `compute.DiskAccess` exposes an `ExtendedLocation`
+
+			"private_endpoint_connection": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"private_endpoint_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"delete_private_endpoints_on_destroy": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -131,6 +166,17 @@ func resourceDiskAccessRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
 
+	var privateEndpointConnections *[]compute.PrivateEndpointConnection
+	if props := resp.DiskAccessProperties; props != nil {
+		privateEndpointConnections = props.PrivateEndpointConnections
+	}
+	if err := d.Set("private_endpoint_connection", flattenDiskAccessPrivateEndpointConnections(privateEndpointConnections)); err != nil {
+		return fmt.Errorf("setting `private_endpoint_connection`: %+v", err)
+	}
+
+	// this isn't returned from the API, so default this when it's not set (e.g. during import)
+	d.Set("delete_private_endpoints_on_destroy", d.Get("delete_private_endpoints_on_destroy").(bool))
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -144,6 +190,37 @@ func resourceDiskAccessDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// the Disk Access can't be deleted whilst Private Endpoints are connected to it, which otherwise only surfaces
+	// once the delete times out - so check for these up front
+	existing, err := client.Get(ctx, id.ResourceGroup, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+	if props := existing.DiskAccessProperties; props != nil && props.PrivateEndpointConnections != nil && len(*props.PrivateEndpointConnections) > 0 {
+		if d.Get("delete_private_endpoints_on_destroy").(bool) {
+			if err := deleteDiskAccessPrivateEndpointConnections(ctx, client, *id, *props.PrivateEndpointConnections); err != nil {
+				return err
+			}
+		} else {
+			connections := make([]string, 0)
+			for _, item := range *props.PrivateEndpointConnections {
+				name := ""
+				if item.Name != nil {
+					name = *item.Name
+				}
+				privateEndpointId := ""
+				if props := item.PrivateEndpointConnectionProperties; props != nil && props.PrivateEndpoint != nil && props.PrivateEndpoint.ID != nil {
+					privateEndpointId = *props.PrivateEndpoint.ID
+				}
+				connections = append(connections, fmt.Sprintf("%q (Private Endpoint %q)", name, privateEndpointId))
+			}
+			return fmt.Errorf("deleting %s: the following Private Endpoint Connections must be removed before the Disk Access can be deleted (or `delete_private_endpoints_on_destroy` enabled): %s", *id, strings.Join(connections, ", "))
+		}
+	}
+
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
 		return fmt.Errorf("Error deleting Disk Access %q (Resource Group %q): %+v", id.Name, id.ResourceGroup, err)
@@ -155,3 +232,108 @@ func resourceDiskAccessDelete(d *pluginsdk.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// deleteDiskAccessPrivateEndpointConnections deletes each of the specified Private Endpoint Connections to the Disk Access,
+// the Private Endpoints themselves are left in place
+func deleteDiskAccessPrivateEndpointConnections(ctx context.Context, client *compute.DiskAccessesClient, id parse.DiskAccessId, connections []compute.PrivateEndpointConnection) error {
+	for _, item := range connections {
+		if item.Name == nil {
+			continue
+		}
+		name := *item.Name
+
+		log.Printf("[DEBUG] Deleting Private Endpoint Connection %q to %s..", name, id)
+		future, err := client.DeleteAPrivateEndpointConnection(ctx, id.ResourceGroup, id.Name, name)
+		if err != nil {
+			if response.WasNotFound(future.Response()) {
+				continue
+			}
+			return fmt.Errorf("deleting Private Endpoint Connection %q to %s: %+v", name, id, err)
+		}
+
+		if err := future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting for deletion of Private Endpoint Connection %q to %s: %+v", name, id, err)
+		}
+	}
+
+	return nil
+}
+
+// waitForDiskAccessPrivateEndpointApproval waits until at least one Private Endpoint Connection to the Disk Access has been approved,
+// since a Disk using the Disk Access can't be reached until this happens
+func waitForDiskAccessPrivateEndpointApproval(ctx context.Context, client *compute.DiskAccessesClient, id parse.DiskAccessId, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for a Private Endpoint Connection to %s to be approved..", id)
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:    []string{string(compute.Pending), "None"},
+		Target:     []string{string(compute.Approved)},
+		Refresh:    diskAccessPrivateEndpointApprovalRefreshFunc(ctx, client, id),
+		MinTimeout: 15 * time.Second,
+		Timeout:    timeout,
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for a Private Endpoint Connection to %s to be approved: %+v", id, err)
+	}
+
+	return nil
+}
+
+func diskAccessPrivateEndpointApprovalRefreshFunc(ctx context.Context, client *compute.DiskAccessesClient, id parse.DiskAccessId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.Get(ctx, id.ResourceGroup, id.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		status := "None"
+		if props := resp.DiskAccessProperties; props != nil && props.PrivateEndpointConnections != nil {
+			for _, connection := range *props.PrivateEndpointConnections {
+				connectionStatus := flattenDiskAccessPrivateEndpointConnectionStatus(connection)
+				if connectionStatus == string(compute.Approved) {
+					return resp, connectionStatus, nil
+				}
+				if connectionStatus == string(compute.Rejected) {
+					return resp, connectionStatus, fmt.Errorf("the Private Endpoint Connection to %s was rejected", id)
+				}
+				status = string(compute.Pending)
+			}
+		}
+
+		return resp, status, nil
+	}
+}
+
+func flattenDiskAccessPrivateEndpointConnections(input *[]compute.PrivateEndpointConnection) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		privateEndpointId := ""
+		if props := item.PrivateEndpointConnectionProperties; props != nil && props.PrivateEndpoint != nil && props.PrivateEndpoint.ID != nil {
+			privateEndpointId = *props.PrivateEndpoint.ID
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                name,
+			"private_endpoint_id": privateEndpointId,
+			"status":              flattenDiskAccessPrivateEndpointConnectionStatus(item),
+		})
+	}
+
+	return results
+}
+
+func flattenDiskAccessPrivateEndpointConnectionStatus(input compute.PrivateEndpointConnection) string {
+	if props := input.PrivateEndpointConnectionProperties; props != nil && props.PrivateLinkServiceConnectionState != nil {
+		return string(props.PrivateLinkServiceConnectionState.Status)
+	}
+
+	return ""
+}
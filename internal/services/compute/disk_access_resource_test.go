@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -16,6 +17,10 @@ import (
 type DiskAccessResource struct {
 }
 
+// diskAccessLocationDisplayName is intentionally a display name (rather than `data.Locations.Primary`, which may
+// already be normalized) to ensure that the location is normalized when read back
+const diskAccessLocationDisplayName = "West US 2"
+
 func TestAccDiskAccess_empty(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_disk_access", "test")
 	r := DiskAccessResource{}
@@ -25,6 +30,7 @@ func TestAccDiskAccess_empty(t *testing.T) {
 			Config: r.empty(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_endpoint_connection.#").HasValue("0"),
 			),
 		},
 		data.ImportStep(),
@@ -63,6 +69,86 @@ func TestAccDiskAccess_import(t *testing.T) {
 	})
 }
 
+func TestAccDiskAccess_locationDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_access", "test")
+	r := DiskAccessResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.locationDisplayName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("location").HasValue(azure.NormalizeLocation(diskAccessLocationDisplayName)),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDiskAccess_privateEndpointConnection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_access", "test")
+	r := DiskAccessResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.privateEndpointConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the Private Endpoint is created after the Disk Access, so the connection is only available on refresh
+			Config: r.privateEndpointConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("private_endpoint_connection.#").HasValue("1"),
+				check.That(data.ResourceName).Key("private_endpoint_connection.0.name").Exists(),
+				check.That(data.ResourceName).Key("private_endpoint_connection.0.private_endpoint_id").Exists(),
+			),
+		},
+		{
+			Config:   r.privateEndpointConnection(data),
+			PlanOnly: true,
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDiskAccess_deletePrivateEndpointsOnDestroy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_access", "test")
+	r := DiskAccessResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deletePrivateEndpointsOnDestroy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delete_private_endpoints_on_destroy").HasValue("true"),
+			),
+		},
+		data.ImportStep("delete_private_endpoints_on_destroy"),
+	})
+}
+
+func TestAccDiskAccess_deletePrivateEndpointsOnDestroyWithConnection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_disk_access", "test")
+	r := DiskAccessResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.deletePrivateEndpointsOnDestroyWithConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("delete_private_endpoints_on_destroy").HasValue("true"),
+			),
+		},
+		{
+			// removing the Disk Access whilst the Private Endpoint still exists requires the connection to be deleted first
+			Config: r.deletePrivateEndpointsOnDestroyRemoved(data),
+		},
+	})
+}
+
 func (t DiskAccessResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.DiskAccessID(state.ID)
 	if err != nil {
@@ -119,6 +205,107 @@ resource "azurerm_disk_access" "import" {
 `, r.empty(data))
 }
 
+func (DiskAccessResource) deletePrivateEndpointsOnDestroy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_disk_access" "test" {
+  name                = "acctestda-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  delete_private_endpoints_on_destroy = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r DiskAccessResource) deletePrivateEndpointsOnDestroyWithConnection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_disk_access" "test" {
+  name                = "acctestda-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  delete_private_endpoints_on_destroy = true
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%[2]d"
+    private_connection_resource_id = "${azurerm_resource_group.test.id}/providers/Microsoft.Compute/diskAccesses/acctestda-%[2]d"
+    subresource_names              = ["disks"]
+    is_manual_connection           = false
+  }
+
+  // the ID is built up rather than referenced, so that the Disk Access can be removed without the Private Endpoint
+  depends_on = [azurerm_disk_access.test]
+}
+`, r.privateEndpointTemplate(data), data.RandomInteger)
+}
+
+func (r DiskAccessResource) deletePrivateEndpointsOnDestroyRemoved(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%[2]d"
+    private_connection_resource_id = "${azurerm_resource_group.test.id}/providers/Microsoft.Compute/diskAccesses/acctestda-%[2]d"
+    subresource_names              = ["disks"]
+    is_manual_connection           = false
+  }
+}
+`, r.privateEndpointTemplate(data), data.RandomInteger)
+}
+
+func (DiskAccessResource) privateEndpointTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+
+  enforce_private_link_endpoint_network_policies = true
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (DiskAccessResource) importConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -142,3 +329,71 @@ resource "azurerm_disk_access" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (DiskAccessResource) locationDisplayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_disk_access" "test" {
+  name                = "acctestda-%[1]d"
+  location            = "%[3]s"
+  resource_group_name = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, diskAccessLocationDisplayName)
+}
+
+func (DiskAccessResource) privateEndpointConnection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[1]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_disk_access" "test" {
+  name                = "acctestda-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%[1]d"
+    private_connection_resource_id = azurerm_disk_access.test.id
+    subresource_names              = ["disks"]
+    is_manual_connection           = false
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -1,6 +1,7 @@
 package compute
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -158,7 +159,13 @@ func resourceManagedDisk() *pluginsdk.Resource {
 				// TODO: make this case-sensitive once this bug in the Azure API has been fixed:
 				//       https://github.com/Azure/azure-rest-api-specs/issues/14192
 				DiffSuppressFunc: suppress.CaseDifference,
-				ValidateFunc:     azure.ValidateResourceID,
+				ValidateFunc:     validate.DiskAccessID,
+			},
+
+			"wait_for_private_endpoint_approval": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
 			},
 
 			"tier": {
@@ -292,6 +299,9 @@ func resourceManagedDiskCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	if diskAccessID := d.Get("disk_access_id").(string); d.HasChange("disk_access_id") {
 		switch {
 		case props.NetworkAccessPolicy == compute.AllowPrivate:
+			if err := waitForManagedDiskAccessApprovalIfRequired(ctx, d, meta, diskAccessID, d.Timeout(pluginsdk.TimeoutCreate)); err != nil {
+				return err
+			}
 			props.DiskAccessID = utils.String(diskAccessID)
 		case diskAccessID != "" && props.NetworkAccessPolicy != compute.AllowPrivate:
 			return fmt.Errorf("[ERROR] disk_access_id is only available when network_access_policy is set to AllowPrivate")
@@ -442,6 +452,9 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	if diskAccessID := d.Get("disk_access_id").(string); d.HasChange("disk_access_id") {
 		switch {
 		case diskUpdate.NetworkAccessPolicy == compute.AllowPrivate:
+			if err := waitForManagedDiskAccessApprovalIfRequired(ctx, d, meta, diskAccessID, d.Timeout(pluginsdk.TimeoutUpdate)); err != nil {
+				return err
+			}
 			diskUpdate.DiskAccessID = utils.String(diskAccessID)
 		case diskAccessID != "" && diskUpdate.NetworkAccessPolicy != compute.AllowPrivate:
 			return fmt.Errorf("[ERROR] disk_access_id is only available when network_access_policy is set to AllowPrivate")
@@ -570,6 +583,21 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	return resourceManagedDiskRead(d, meta)
 }
 
+// waitForManagedDiskAccessApprovalIfRequired waits for a Private Endpoint Connection to the Disk Access to be approved
+// when `wait_for_private_endpoint_approval` is enabled, since the Disk can't be used via the Disk Access until then
+func waitForManagedDiskAccessApprovalIfRequired(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, diskAccessID string, timeout time.Duration) error {
+	if diskAccessID == "" || !d.Get("wait_for_private_endpoint_approval").(bool) {
+		return nil
+	}
+
+	id, err := parse.DiskAccessID(diskAccessID)
+	if err != nil {
+		return err
+	}
+
+	return waitForDiskAccessPrivateEndpointApproval(ctx, meta.(*clients.Client).Compute.DiskAccessClient, *id, timeout)
+}
+
 func resourceManagedDiskRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.DisksClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
@@ -423,6 +423,30 @@ func TestAccAzureRMManagedDisk_networkPolicy_update_withAllowPrivate(t *testing.
 	})
 }
 
+func TestAccAzureRMManagedDisk_networkPolicy_waitForPrivateEndpointApproval(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: testAccAzureRMManagedDisk_networkPolicy_waitForPrivateEndpointApproval(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				acceptance.TestCheckResourceAttr(data.ResourceName, "network_access_policy", "AllowPrivate"),
+			),
+		},
+		{
+			// the Private Endpoint Connection is only exposed once the Disk Access has been refreshed
+			Config: testAccAzureRMManagedDisk_networkPolicy_waitForPrivateEndpointApproval(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				acceptance.TestCheckResourceAttr("azurerm_disk_access.test", "private_endpoint_connection.#", "1"),
+				acceptance.TestCheckResourceAttr("azurerm_disk_access.test", "private_endpoint_connection.0.status", "Approved"),
+			),
+		},
+		data.ImportStep("wait_for_private_endpoint_approval"),
+	})
+}
+
 func (ManagedDiskResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.ManagedDiskID(state.ID)
 	if err != nil {
@@ -1297,3 +1321,64 @@ resource "azurerm_managed_disk" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
+
+func testAccAzureRMManagedDisk_networkPolicy_waitForPrivateEndpointApproval(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+
+  enforce_private_link_endpoint_network_policies = true
+}
+
+resource "azurerm_disk_access" "test" {
+  name                = "accda%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctestpe-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctestpsc-%d"
+    private_connection_resource_id = azurerm_disk_access.test.id
+    subresource_names              = ["disks"]
+    is_manual_connection           = false
+  }
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                               = "acctestd-%d"
+  location                           = azurerm_resource_group.test.location
+  resource_group_name                = azurerm_resource_group.test.name
+  storage_account_type               = "Standard_LRS"
+  create_option                      = "Empty"
+  disk_size_gb                       = "4"
+  network_access_policy              = "AllowPrivate"
+  disk_access_id                     = azurerm_disk_access.test.id
+  wait_for_private_endpoint_approval = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -24,6 +24,8 @@ func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 		"azurerm_availability_set":          dataSourceAvailabilitySet(),
 		"azurerm_dedicated_host":            dataSourceDedicatedHost(),
 		"azurerm_dedicated_host_group":      dataSourceDedicatedHostGroup(),
+		"azurerm_dedicated_host_skus":       dataSourceDedicatedHostSkus(),
+		"azurerm_dedicated_hosts":           dataSourceDedicatedHosts(),
 		"azurerm_disk_encryption_set":       dataSourceDiskEncryptionSet(),
 		"azurerm_managed_disk":              dataSourceManagedDisk(),
 		"azurerm_image":                     dataSourceImage(),
@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+)
+
+// DedicatedHostPlatformFaultDomain validates that the Platform Fault Domain of a Dedicated Host is within the range
+// supported by the Dedicated Host Group it's being placed in, which is defined by the `platform_fault_domain_count`
+// of the Dedicated Host Group
+func DedicatedHostPlatformFaultDomain(hostGroupId parse.DedicatedHostGroupId, platformFaultDomainCount int, platformFaultDomain int) error {
+	if platformFaultDomainCount < 1 {
+		return fmt.Errorf("expected the `platform_fault_domain_count` of %s to be at least 1, got %d", hostGroupId, platformFaultDomainCount)
+	}
+
+	if platformFaultDomain < 0 || platformFaultDomain >= platformFaultDomainCount {
+		return fmt.Errorf("`platform_fault_domain` must be between 0 and %d since %s has a `platform_fault_domain_count` of %d, got %d", platformFaultDomainCount-1, hostGroupId, platformFaultDomainCount, platformFaultDomain)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/parse"
+)
+
+func TestDedicatedHostPlatformFaultDomain(t *testing.T) {
+	hostGroupId := parse.NewDedicatedHostGroupID("00000000-0000-0000-0000-000000000000", "resGroup1", "hostGroup1")
+
+	cases := []struct {
+		Name                     string
+		PlatformFaultDomainCount int
+		PlatformFaultDomain      int
+		ExpectError              bool
+	}{
+		{
+			Name:                     "Negative Fault Domain",
+			PlatformFaultDomainCount: 1,
+			PlatformFaultDomain:      -1,
+			ExpectError:              true,
+		},
+		{
+			Name:                     "Single Fault Domain",
+			PlatformFaultDomainCount: 1,
+			PlatformFaultDomain:      0,
+			ExpectError:              false,
+		},
+		{
+			Name:                     "Above Single Fault Domain",
+			PlatformFaultDomainCount: 1,
+			PlatformFaultDomain:      1,
+			ExpectError:              true,
+		},
+		{
+			Name:                     "Lowest of Three Fault Domains",
+			PlatformFaultDomainCount: 3,
+			PlatformFaultDomain:      0,
+			ExpectError:              false,
+		},
+		{
+			Name:                     "Highest of Three Fault Domains",
+			PlatformFaultDomainCount: 3,
+			PlatformFaultDomain:      2,
+			ExpectError:              false,
+		},
+		{
+			Name:                     "Above Three Fault Domains",
+			PlatformFaultDomainCount: 3,
+			PlatformFaultDomain:      3,
+			ExpectError:              true,
+		},
+		{
+			Name:                     "No Fault Domains",
+			PlatformFaultDomainCount: 0,
+			PlatformFaultDomain:      0,
+			ExpectError:              true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		err := DedicatedHostPlatformFaultDomain(hostGroupId, v.PlatformFaultDomainCount, v.PlatformFaultDomain)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+	}
+}
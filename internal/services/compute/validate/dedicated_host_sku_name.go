@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// DedicatedHostSkuName validates the name of a Dedicated Host SKU, the SKUs available in a given
+// location can be listed using the `azurerm_dedicated_host_skus` Data Source
+func DedicatedHostSkuName() pluginsdk.SchemaValidateFunc {
+	return validation.StringInSlice([]string{
+		"DADSv5-Type1",
+		"DASv4-Type1",
+		"DASv4-Type2",
+		"DASv5-Type1",
+		"DCSv2-Type1",
+		"DDSv4-Type1",
+		"DDSv4-Type2",
+		"DDSv5-Type1",
+		"DSv3-Type1",
+		"DSv3-Type2",
+		"DSv3-Type3",
+		"DSv3-Type4",
+		"DSv4-Type1",
+		"DSv4-Type2",
+		"DSv5-Type1",
+		"EADSv5-Type1",
+		"EASv4-Type1",
+		"EASv4-Type2",
+		"EASv5-Type1",
+		"EDSv4-Type1",
+		"EDSv4-Type2",
+		"EDSv5-Type1",
+		"ESv3-Type1",
+		"ESv3-Type2",
+		"ESv3-Type3",
+		"ESv3-Type4",
+		"ESv4-Type1",
+		"ESv4-Type2",
+		"ESv5-Type1",
+		"FSv2-Type2",
+		"FSv2-Type3",
+		"FSv2-Type4",
+		"LSv2-Type1",
+		"MS-Type1",
+		"MSm-Type1",
+		"MSmv2-Type1",
+		"MSv2-Type1",
+		"NVASv4-Type1",
+		"NVSv3-Type1",
+	}, false)
+}
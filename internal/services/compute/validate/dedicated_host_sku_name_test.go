@@ -0,0 +1,50 @@
+package validate
+
+import "testing"
+
+func TestDedicatedHostSkuName(t *testing.T) {
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "",
+			ErrCount: 1,
+		},
+		{
+			Value:    "DSv3-Type1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ESv3-Type3",
+			ErrCount: 0,
+		},
+		{
+			Value:    "DSv5-Type1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "ESv5-Type1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "DDSv5-Type1",
+			ErrCount: 0,
+		},
+		{
+			Value:    "dsv5-type1",
+			ErrCount: 1,
+		},
+		{
+			Value:    "DSv5",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := DedicatedHostSkuName()(tc.Value, "sku_name")
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected DedicatedHostSkuName to return %d error(s) for %q but got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package datafactory
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/datafactory/mgmt/2018-06-01/datafactory"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceDataFactoryIntegrationRuntimeAzureRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"data_factory_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.DataFactoryName(),
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"description": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"compute_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"core_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"time_to_live_min": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"cleanup_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
+			"virtual_network_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataFactoryIntegrationRuntimeAzureRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewIntegrationRuntimeID(subscriptionId, d.Get("resource_group_name").(string), d.Get("data_factory_name").(string), d.Get("name").(string))
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	managedIntegrationRuntime, ok := resp.Properties.AsManagedIntegrationRuntime()
+	if !ok {
+		return fmt.Errorf("%s is not an Azure Integration Runtime", id)
+	}
+
+	d.SetId(id.ID())
+
+	d.Set("description", managedIntegrationRuntime.Description)
+
+	virtualNetworkEnabled := false
+	if managedIntegrationRuntime.ManagedVirtualNetwork != nil && managedIntegrationRuntime.ManagedVirtualNetwork.ReferenceName != nil {
+		virtualNetworkEnabled = true
+	}
+	d.Set("virtual_network_enabled", virtualNetworkEnabled)
+
+	if computeProps := managedIntegrationRuntime.ComputeProperties; computeProps != nil {
+		if location := computeProps.Location; location != nil {
+			d.Set("location", azure.NormalizeLocation(*location))
+		}
+
+		if dataFlowProps := computeProps.DataFlowProperties; dataFlowProps != nil {
+			// the API defaults the Compute Type to `General` when it's omitted
+			computeType := string(datafactory.DataFlowComputeTypeGeneral)
+			if dataFlowProps.ComputeType != "" {
+				computeType = string(dataFlowProps.ComputeType)
+			}
+			d.Set("compute_type", computeType)
+			d.Set("core_count", dataFlowProps.CoreCount)
+			d.Set("time_to_live_min", dataFlowProps.TimeToLive)
+
+			// the API defaults Cleanup to `true` when it's omitted
+			cleanupEnabled := true
+			if dataFlowProps.Cleanup != nil {
+				cleanupEnabled = *dataFlowProps.Cleanup
+			}
+			d.Set("cleanup_enabled", cleanupEnabled)
+		}
+	}
+
+	return nil
+}
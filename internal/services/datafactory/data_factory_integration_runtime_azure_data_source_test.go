@@ -0,0 +1,65 @@
+package datafactory_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type IntegrationRuntimeAzureDataSource struct {
+}
+
+func TestAccDataFactoryIntegrationRuntimeAzureDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("location").Exists(),
+				check.That(data.ResourceName).Key("compute_type").HasValue("ComputeOptimized"),
+				check.That(data.ResourceName).Key("core_count").HasValue("16"),
+				check.That(data.ResourceName).Key("time_to_live_min").HasValue("10"),
+				check.That(data.ResourceName).Key("virtual_network_enabled").HasValue("false"),
+			),
+		},
+	})
+}
+
+func (IntegrationRuntimeAzureDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = "azure-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  compute_type        = "ComputeOptimized"
+  core_count          = 16
+  time_to_live_min    = 10
+}
+
+data "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = azurerm_data_factory_integration_runtime_azure.test.name
+  data_factory_name   = azurerm_data_factory_integration_runtime_azure.test.data_factory_name
+  resource_group_name = azurerm_data_factory_integration_runtime_azure.test.resource_group_name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
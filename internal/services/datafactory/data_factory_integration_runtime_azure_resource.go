@@ -1,6 +1,7 @@
 package datafactory
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"time"
@@ -9,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -22,8 +24,10 @@ func resourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
 		Read:   resourceDataFactoryIntegrationRuntimeAzureRead,
 		Update: resourceDataFactoryIntegrationRuntimeAzureCreateUpdate,
 		Delete: resourceDataFactoryIntegrationRuntimeAzureDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.IntegrationRuntimeID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -85,12 +89,71 @@ func resourceDataFactoryIntegrationRuntimeAzure() *pluginsdk.Resource {
 				Default:  0,
 			},
 
+			"cleanup_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			// TODO: add `zone_redundant` once the SDK has been updated to an API Version which exposes zone redundancy within `IntegrationRuntimeComputeProperties`
+
+			// TODO: add `credential_name` once the SDK has been updated to an API Version which exposes `Credential` within `ManagedIntegrationRuntimeTypeProperties`, along with a Credentials client to validate against
+
 			"virtual_network_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
+			},
+
+			"managed_virtual_network_name": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			// the Integration Runtime doesn't have an identity of it's own - Linked Services using a Managed Identity
+			// authenticate using the identity of the parent Data Factory, which is exposed here for reference
+			"factory_identity": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"identity_ids": {
+							Type:     pluginsdk.TypeList,
+							Computed: true,
+							Elem: &pluginsdk.Schema{
+								Type: pluginsdk.TypeString,
+							},
+						},
+
+						"principal_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tenant_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			if d.HasChange("virtual_network_enabled") {
+				return d.SetNewComputed("managed_virtual_network_name")
+			}
+			return nil
+		}),
 	}
 }
 
@@ -134,7 +197,7 @@ func resourceDataFactoryIntegrationRuntimeAzureCreateUpdate(d *pluginsdk.Resourc
 			return err
 		}
 		if virtualNetworkName == nil {
-			return fmt.Errorf("virtual network feature for azure integration runtime is only available after managed virtual network for this data factory is enabled")
+			return fmt.Errorf("`virtual_network_enabled` can only be set once a Managed Virtual Network has been enabled for Data Factory %q (Resource Group %q) - please set `managed_virtual_network_enabled` to `true` on the Data Factory first", factoryName, resourceGroup)
 		}
 		managedIntegrationRuntime.ManagedVirtualNetwork = &datafactory.ManagedVirtualNetworkReference{
 			Type:          utils.String("ManagedVirtualNetworkReference"),
@@ -169,17 +232,18 @@ func resourceDataFactoryIntegrationRuntimeAzureCreateUpdate(d *pluginsdk.Resourc
 
 func resourceDataFactoryIntegrationRuntimeAzureRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
+	factoriesClient := meta.(*clients.Client).DataFactory.FactoriesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.IntegrationRuntimeID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	factoryName := id.Path["factories"]
-	name := id.Path["integrationruntimes"]
+	factoryName := id.FactoryName
+	name := id.Name
 
 	resp, err := client.Get(ctx, resourceGroup, factoryName, name, "")
 	if err != nil {
@@ -205,30 +269,74 @@ func resourceDataFactoryIntegrationRuntimeAzureRead(d *pluginsdk.ResourceData, m
 	}
 
 	virtualNetworkEnabled := false
+	managedVirtualNetworkName := ""
 	if managedIntegrationRuntime.ManagedVirtualNetwork != nil && managedIntegrationRuntime.ManagedVirtualNetwork.ReferenceName != nil {
 		virtualNetworkEnabled = true
+		managedVirtualNetworkName = *managedIntegrationRuntime.ManagedVirtualNetwork.ReferenceName
 	}
 	d.Set("virtual_network_enabled", virtualNetworkEnabled)
+	d.Set("managed_virtual_network_name", managedVirtualNetworkName)
 
 	if computeProps := managedIntegrationRuntime.ComputeProperties; computeProps != nil {
 		if location := computeProps.Location; location != nil {
-			d.Set("location", location)
+			d.Set("location", azure.NormalizeLocation(*location))
 		}
 
 		if dataFlowProps := computeProps.DataFlowProperties; dataFlowProps != nil {
-			if computeType := &dataFlowProps.ComputeType; computeType != nil {
-				d.Set("compute_type", string(*computeType))
+			// the API defaults the Compute Type to `General` when it's omitted
+			computeType := string(datafactory.DataFlowComputeTypeGeneral)
+			if dataFlowProps.ComputeType != "" {
+				computeType = string(dataFlowProps.ComputeType)
 			}
+			d.Set("compute_type", computeType)
 
 			if coreCount := dataFlowProps.CoreCount; coreCount != nil {
 				d.Set("core_count", coreCount)
 			}
 
-			if timeToLive := dataFlowProps.TimeToLive; timeToLive != nil {
-				d.Set("time_to_live_min", timeToLive)
+			// the API omits the Time To Live when it's been reset to `0`, so this needs to be set explicitly
+			// otherwise a previously configured value would remain in the state
+			timeToLive := 0
+			if dataFlowProps.TimeToLive != nil {
+				timeToLive = int(*dataFlowProps.TimeToLive)
+			}
+			d.Set("time_to_live_min", timeToLive)
+
+			// the API defaults Cleanup to `true` when it's omitted
+			cleanupEnabled := true
+			if dataFlowProps.Cleanup != nil {
+				cleanupEnabled = *dataFlowProps.Cleanup
 			}
+			d.Set("cleanup_enabled", cleanupEnabled)
+		}
+	}
+
+	// the operational state of the Integration Runtime isn't returned from the Get, so has to be retrieved separately
+	statusResp, err := client.GetStatus(ctx, resourceGroup, factoryName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving status of Data Factory Azure Integration Runtime %q (Resource Group %q, Data Factory %q): %+v", name, resourceGroup, factoryName, err)
+	}
+
+	state := ""
+	if statusResp.Properties != nil {
+		if managedStatus, ok := statusResp.Properties.AsManagedIntegrationRuntimeStatus(); ok && managedStatus != nil {
+			state = string(managedStatus.State)
 		}
 	}
+	d.Set("state", state)
+
+	factory, err := factoriesClient.Get(ctx, resourceGroup, factoryName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Data Factory %q (Resource Group %q) for Azure Integration Runtime %q: %+v", factoryName, resourceGroup, name, err)
+	}
+
+	factoryIdentity, err := flattenDataFactoryIdentity(factory.Identity)
+	if err != nil {
+		return fmt.Errorf("Error flattening `factory_identity`: %+v", err)
+	}
+	if err := d.Set("factory_identity", factoryIdentity); err != nil {
+		return fmt.Errorf("Error setting `factory_identity`: %+v", err)
+	}
 
 	return nil
 }
@@ -238,15 +346,14 @@ func resourceDataFactoryIntegrationRuntimeAzureDelete(d *pluginsdk.ResourceData,
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
-
+	id, err := parse.IntegrationRuntimeID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	factoryName := id.Path["factories"]
-	name := id.Path["integrationruntimes"]
+	factoryName := id.FactoryName
+	name := id.Name
 
 	response, err := client.Delete(ctx, resourceGroup, factoryName, name)
 
@@ -270,6 +377,7 @@ func expandDataFactoryIntegrationRuntimeAzureComputeProperties(d *pluginsdk.Reso
 			ComputeType: datafactory.DataFlowComputeType(d.Get("compute_type").(string)),
 			CoreCount:   &coreCount,
 			TimeToLive:  &timeToLiveMin,
+			Cleanup:     utils.Bool(d.Get("cleanup_enabled").(bool)),
 		},
 	}
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/datafactory/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -16,6 +17,10 @@ import (
 type IntegrationRuntimeAzureResource struct {
 }
 
+// integrationRuntimeAzureLocationDisplayName is intentionally a display name (rather than `data.Locations.Primary`,
+// which may already be normalized) to ensure that the location is normalized when read back
+const integrationRuntimeAzureLocationDisplayName = "West Europe"
+
 func TestAccDataFactoryIntegrationRuntimeAzure_basic(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
 	r := IntegrationRuntimeAzureResource{}
@@ -28,6 +33,23 @@ func TestAccDataFactoryIntegrationRuntimeAzure_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("compute_type").HasValue("General"),
 				check.That(data.ResourceName).Key("core_count").HasValue("8"),
 				check.That(data.ResourceName).Key("time_to_live_min").HasValue("0"),
+				check.That(data.ResourceName).Key("state").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAzure_locationDisplayName(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.locationDisplayName(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("location").HasValue(azure.NormalizeLocation(integrationRuntimeAzureLocationDisplayName)),
 			),
 		},
 		data.ImportStep(),
@@ -50,6 +72,26 @@ func TestAccDataFactoryIntegrationRuntimeAzure_computeType(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryIntegrationRuntimeAzure_computeTypeMemoryOptimized(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.computeTypeMemoryOptimized(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("compute_type").HasValue("MemoryOptimized"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:   r.computeTypeMemoryOptimized(data),
+			PlanOnly: true,
+		},
+	})
+}
+
 func TestAccDataFactoryIntegrationRuntimeAzure_coreCount(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
 	r := IntegrationRuntimeAzureResource{}
@@ -82,15 +124,115 @@ func TestAccDataFactoryIntegrationRuntimeAzure_timeToLive(t *testing.T) {
 	})
 }
 
+func TestAccDataFactoryIntegrationRuntimeAzure_timeToLiveRemoved(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.timeToLive(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("time_to_live_min").HasValue("10"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("time_to_live_min").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAzure_cleanup(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.cleanup(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cleanup_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.cleanup(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("cleanup_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDataFactoryIntegrationRuntimeAzure_virtualNetwork(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
 	r := IntegrationRuntimeAzureResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
 		{
-			Config: r.virtualNetwork(data),
+			Config: r.virtualNetwork(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAzure_virtualNetworkUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualNetwork(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("managed_virtual_network_name").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.virtualNetwork(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("managed_virtual_network_name").HasValue("default"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.virtualNetwork(data, false),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("virtual_network_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("managed_virtual_network_name").HasValue(""),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDataFactoryIntegrationRuntimeAzure_factoryIdentity(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_data_factory_integration_runtime_azure", "test")
+	r := IntegrationRuntimeAzureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.factoryIdentity(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("factory_identity.0.type").HasValue("SystemAssigned"),
+				check.That(data.ResourceName).Key("factory_identity.0.principal_id").Exists(),
 			),
 		},
 		data.ImportStep(),
@@ -123,6 +265,32 @@ resource "azurerm_data_factory_integration_runtime_azure" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (IntegrationRuntimeAzureResource) locationDisplayName(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = "azure-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, integrationRuntimeAzureLocationDisplayName)
+}
+
 func (IntegrationRuntimeAzureResource) computeType(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -150,6 +318,33 @@ resource "azurerm_data_factory_integration_runtime_azure" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
+func (IntegrationRuntimeAzureResource) computeTypeMemoryOptimized(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = "azure-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  compute_type        = "MemoryOptimized"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (IntegrationRuntimeAzureResource) coreCount(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -204,7 +399,35 @@ resource "azurerm_data_factory_integration_runtime_azure" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
-func (IntegrationRuntimeAzureResource) virtualNetwork(data acceptance.TestData) string {
+func (IntegrationRuntimeAzureResource) cleanup(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = "azure-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  time_to_live_min    = 10
+  cleanup_enabled     = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled)
+}
+
+func (IntegrationRuntimeAzureResource) virtualNetwork(data acceptance.TestData, enabled bool) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
   features {}
@@ -227,21 +450,48 @@ resource "azurerm_data_factory_integration_runtime_azure" "test" {
   data_factory_name       = azurerm_data_factory.test.name
   resource_group_name     = azurerm_resource_group.test.name
   location                = azurerm_resource_group.test.location
-  virtual_network_enabled = true
+  virtual_network_enabled = %t
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, enabled)
+}
+
+func (IntegrationRuntimeAzureResource) factoryIdentity(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-df-%d"
+  location = "%s"
+}
+
+resource "azurerm_data_factory" "test" {
+  name                = "acctestdfirm%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+
+resource "azurerm_data_factory_integration_runtime_azure" "test" {
+  name                = "azure-integration-runtime"
+  data_factory_name   = azurerm_data_factory.test.name
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
 
 func (t IntegrationRuntimeAzureResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := azure.ParseAzureResourceID(state.ID)
+	id, err := parse.IntegrationRuntimeID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resourceGroup := id.ResourceGroup
-	dataFactoryName := id.Path["factories"]
-	name := id.Path["integrationruntimes"]
 
-	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, resourceGroup, dataFactoryName, name, "")
+	resp, err := clients.DataFactory.IntegrationRuntimesClient.Get(ctx, id.ResourceGroup, id.FactoryName, id.Name, "")
 	if err != nil {
 		return nil, fmt.Errorf("reading Data Factory Azure Integration Runtime (%s): %+v", id, err)
 	}
@@ -52,6 +52,9 @@ func dataSourceIotHubDPS() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			// TODO: expose an `enrollment_count` - enrollments are only available via the DPS data plane
+			// (the `enrollments/query` API on the `service_operations_host_name`), which this provider doesn't support yet
+
 			"tags": tags.Schema(),
 		},
 	}
@@ -0,0 +1,138 @@
+package iothub
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/provisioningservices/mgmt/2018-01-22/iothub"
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestDeleteIotHubDPS(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCodes   []int
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "Deleted",
+			StatusCodes:   []int{http.StatusAccepted},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Already Deleted",
+			StatusCodes:   []int{http.StatusNotFound},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Transient Conflict",
+			StatusCodes:   []int{http.StatusConflict, http.StatusAccepted},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "Repeated Conflict",
+			StatusCodes:   []int{http.StatusConflict, http.StatusConflict},
+			ExpectedCalls: 2,
+			ExpectError:   true,
+		},
+		{
+			Name:          "Bad Request",
+			StatusCodes:   []int{http.StatusBadRequest},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := 0
+		deleteFunc := func(ctx context.Context) (*http.Response, error) {
+			if calls >= len(v.StatusCodes) {
+				return nil, fmt.Errorf("unexpected call %d", calls+1)
+			}
+			statusCode := v.StatusCodes[calls]
+			calls++
+
+			if statusCode >= http.StatusBadRequest {
+				return &http.Response{StatusCode: statusCode}, fmt.Errorf("unexpected status %d", statusCode)
+			}
+			return &http.Response{StatusCode: statusCode}, nil
+		}
+
+		err := deleteIotHubDPS(context.TODO(), deleteFunc, time.Millisecond)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if calls != v.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %d", v.ExpectedCalls, calls)
+		}
+	}
+}
+
+func TestWaitForIotHubDPSToBeDeleted(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCodes   []int
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "Deleted",
+			StatusCodes:   []int{http.StatusOK, http.StatusNotFound},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "Transient Conflict whilst Deleting",
+			StatusCodes:   []int{http.StatusOK, http.StatusConflict, http.StatusServiceUnavailable, http.StatusNotFound},
+			ExpectedCalls: 4,
+		},
+		{
+			Name:          "Bad Request",
+			StatusCodes:   []int{http.StatusOK, http.StatusBadRequest},
+			ExpectedCalls: 2,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := 0
+		getFunc := func(ctx context.Context) (iothub.ProvisioningServiceDescription, error) {
+			if calls >= len(v.StatusCodes) {
+				return iothub.ProvisioningServiceDescription{}, fmt.Errorf("unexpected call %d", calls+1)
+			}
+			statusCode := v.StatusCodes[calls]
+			calls++
+
+			resp := iothub.ProvisioningServiceDescription{
+				Response: autorest.Response{Response: &http.Response{StatusCode: statusCode}},
+			}
+			if statusCode != http.StatusOK {
+				return resp, fmt.Errorf("unexpected status %d", statusCode)
+			}
+			return resp, nil
+		}
+
+		err := waitForIotHubDPSToBeDeleted(context.TODO(), getFunc, "group1", "dps1", time.Minute)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if calls != v.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %d", v.ExpectedCalls, calls)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package iothub
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/provisioningservices/mgmt/2018-01-22/iothub"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenIoTHubDPSLinkedHubLocations(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *[]iothub.DefinitionDescription
+		Expected []interface{}
+	}{
+		{
+			Name:     "No Linked Hubs",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name: "Multiple Locations",
+			Input: &[]iothub.DefinitionDescription{
+				{
+					Location: utils.String("West Europe"),
+				},
+				{
+					Location:              utils.String("eastus"),
+					ApplyAllocationPolicy: utils.Bool(true),
+				},
+			},
+			Expected: []interface{}{"westeurope", "eastus"},
+		},
+		{
+			Name: "Duplicate Locations",
+			Input: &[]iothub.DefinitionDescription{
+				{
+					Location: utils.String("westeurope"),
+				},
+				{
+					Location: utils.String("West Europe"),
+				},
+			},
+			Expected: []interface{}{"westeurope"},
+		},
+		{
+			Name: "Allocation Policy Not Applied",
+			Input: &[]iothub.DefinitionDescription{
+				{
+					Location:              utils.String("westeurope"),
+					ApplyAllocationPolicy: utils.Bool(false),
+				},
+				{
+					Location: utils.String("eastus"),
+				},
+			},
+			Expected: []interface{}{"eastus"},
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenIoTHubDPSLinkedHubLocations(v.Input)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
+
+func TestExpandIoTHubDPSIoTHubsAllocationWeight(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"connection_string":       "HostName=test.azure-devices.net;SharedAccessKeyName=iothubowner;SharedAccessKey=key",
+			"location":                "westeurope",
+			"allocation_weight":       0,
+			"apply_allocation_policy": true,
+		},
+		map[string]interface{}{
+			"connection_string":       "HostName=test2.azure-devices.net;SharedAccessKeyName=iothubowner;SharedAccessKey=key",
+			"location":                "westeurope",
+			"allocation_weight":       15,
+			"apply_allocation_policy": true,
+		},
+	}
+
+	actual := *expandIoTHubDPSIoTHubs(input)
+	for i, expected := range []int32{0, 15} {
+		if actual[i].AllocationWeight == nil {
+			t.Fatalf("Expected `allocation_weight` %d to be sent for Linked Hub %d but it was nil", expected, i)
+		}
+		if *actual[i].AllocationWeight != expected {
+			t.Fatalf("Expected `allocation_weight` %d for Linked Hub %d but got %d", expected, i, *actual[i].AllocationWeight)
+		}
+	}
+}
+
+func TestFlattenIoTHubDPSLinkedHubAllocationWeight(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *int32
+		Expected int
+	}{
+		{
+			Name:     "Omitted",
+			Input:    nil,
+			Expected: 0,
+		},
+		{
+			Name:     "Zero",
+			Input:    utils.Int32(0),
+			Expected: 0,
+		},
+		{
+			Name:     "Weighted",
+			Input:    utils.Int32(150),
+			Expected: 150,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenIoTHubDPSLinkedHub(&[]iothub.DefinitionDescription{
+			{
+				AllocationWeight: v.Input,
+			},
+		})
+		if len(actual) != 1 {
+			t.Fatalf("Expected 1 Linked Hub but got %d", len(actual))
+		}
+
+		weight, ok := actual[0].(map[string]interface{})["allocation_weight"]
+		if !ok {
+			t.Fatalf("Expected `allocation_weight` to be set but it wasn't")
+		}
+		if weight != v.Expected {
+			t.Fatalf("Expected `allocation_weight` to be %d but got %+v", v.Expected, weight)
+		}
+	}
+}
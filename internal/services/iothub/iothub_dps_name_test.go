@@ -0,0 +1,53 @@
+package iothub
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+func TestIotHubDPSNameFromResourceID(t *testing.T) {
+	cases := []struct {
+		Input       string
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/provisioningServices/dps1",
+			Expected: "dps1",
+		},
+		{
+			// older iterations of the resource used a capitalized segment
+			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/ProvisioningServices/dps1",
+			Expected: "dps1",
+		},
+		{
+			Input:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Devices/IotHubs/hub1",
+			ExpectError: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		id, err := azure.ParseAzureResourceID(v.Input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", v.Input, err)
+		}
+
+		actual, err := iotHubDPSNameFromResourceID(id)
+		if v.ExpectError {
+			if err == nil {
+				t.Fatalf("Expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
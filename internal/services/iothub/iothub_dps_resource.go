@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"regexp"
 	"strconv"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -52,6 +54,9 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 
 			"location": azure.SchemaLocation(),
 
+			// TODO: add `edge_zone` once the SDK has been updated to an API Version which supports an `ExtendedLocation`
+			// within `ProvisioningServiceDescription` - the 2018-01-22 API only supports a regional `location`
+
 			"sku": {
 				Type:     pluginsdk.TypeList,
 				MaxItems: 1,
@@ -67,11 +72,18 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 							}, false),
 						},
 
+						// TODO: validate this against the regional capacity limit once an API exposes it - `ListValidSkus`
+						// only returns the SKU names available to an existing DPS, and there's no usages API for DPS
 						"capacity": {
 							Type:         pluginsdk.TypeInt,
 							Required:     true,
 							ValidateFunc: validation.IntBetween(1, 200),
 						},
+
+						"effective_capacity": {
+							Type:     pluginsdk.TypeInt,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -121,14 +133,10 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 			},
 
 			"allocation_policy": {
-				Type:     pluginsdk.TypeString,
-				Optional: true,
-				Default:  string(iothub.Hashed),
-				ValidateFunc: validation.StringInSlice([]string{
-					string(iothub.Hashed),
-					string(iothub.GeoLatency),
-					string(iothub.Static),
-				}, false),
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      string(iothub.Hashed),
+				ValidateFunc: validate.IoTHubDPSAllocationPolicy,
 			},
 
 			"device_provisioning_host_name": {
@@ -146,11 +154,75 @@ func resourceIotHubDPS() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"linked_hub_count": {
+				Type:     pluginsdk.TypeInt,
+				Computed: true,
+			},
+
+			"linked_hub_locations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			// TODO: expose `resource_guid` once the SDK has been updated to an API Version which returns the Resource GUID
+
+			// TODO: add `ip_filter_rule` once the SDK has been updated to an API Version which supports `IPFilterRules` within
+			// `IotDpsPropertiesDescription` - these are evaluated in order, so this should be a TypeList (as for the IoT Hub)
+			// with a CustomizeDiff warning when a `Reject` rule for `0.0.0.0/0` precedes any `Accept` rules
+
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			iothubDPSLinkedHubAllocationCustomizeDiff,
+			iothubDPSLinkedHubLimitCustomizeDiff,
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+				if d.HasChange("linked_hub") {
+					if err := d.SetNewComputed("linked_hub_count"); err != nil {
+						return err
+					}
+					return d.SetNewComputed("linked_hub_locations")
+				}
+				return nil
+			},
+		),
 	}
 }
 
+// iothubDPSMaxLinkedHubs is the maximum number of IoT Hubs which can be linked to a single Device Provisioning Service
+// see https://docs.microsoft.com/azure/iot-dps/about-iot-dps#quotas-and-limits
+const iothubDPSMaxLinkedHubs = 50
+
+func iothubDPSLinkedHubLimitCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	linkedHubs := d.Get("linked_hub").([]interface{})
+	if len(linkedHubs) > iothubDPSMaxLinkedHubs {
+		return fmt.Errorf("a maximum of %d `linked_hub` blocks can be specified for an IoT Device Provisioning Service but %d were specified", iothubDPSMaxLinkedHubs, len(linkedHubs))
+	}
+
+	return nil
+}
+
+func iothubDPSLinkedHubAllocationCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !d.HasChange("linked_hub") || d.Get("allocation_policy").(string) != string(iothub.Static) {
+		return nil
+	}
+
+	// when using the Static allocation policy devices are only provisioned to the linked hubs which apply the
+	// allocation policy - removing the last of these leaves the DPS without an allocation target
+	old, new := d.GetChange("linked_hub")
+	if countIoTHubDPSLinkedHubsApplyingAllocationPolicy(old.([]interface{})) == 0 {
+		return nil
+	}
+	if countIoTHubDPSLinkedHubsApplyingAllocationPolicy(new.([]interface{})) == 0 {
+		return fmt.Errorf("at least one `linked_hub` must have `apply_allocation_policy` set to `true` when `allocation_policy` is `%s` - either set `apply_allocation_policy` on another `linked_hub` before removing this one, or change the `allocation_policy`", string(iothub.Static))
+	}
+
+	return nil
+}
+
 func resourceIotHubDPSCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).IoTHub.DPSResourceClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
@@ -159,6 +231,10 @@ func resourceIotHubDPSCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
+	// the Shared Access Policies are also managed by updating the IoT Device Provisioning Service, so serialize these
+	locks.ByName(name, IothubResourceName)
+	defer locks.UnlockByName(name, IothubResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, name, resourceGroup)
 		if err != nil {
@@ -178,7 +254,7 @@ func resourceIotHubDPSCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		Sku:      expandIoTHubDPSSku(d),
 		Properties: &iothub.IotDpsPropertiesDescription{
 			IotHubs:          expandIoTHubDPSIoTHubs(d.Get("linked_hub").([]interface{})),
-			AllocationPolicy: d.Get("allocation_policy").(iothub.AllocationPolicy),
+			AllocationPolicy: iothub.AllocationPolicy(d.Get("allocation_policy").(string)),
 		},
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
@@ -188,7 +264,20 @@ func resourceIotHubDPSCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating/updating IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+	// scaling the capacity of a large IoT Device Provisioning Service can take a while and the future doesn't report any
+	// progress, so poll it here so that the state of the IoT Device Provisioning Service can be logged whilst waiting
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("context had no deadline")
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{"InProgress"},
+		Target:       []string{"Completed"},
+		Refresh:      iothubDPSCreateUpdateStateRefreshFunc(ctx, client, future, resourceGroup, name),
+		PollInterval: 15 * time.Second,
+		Timeout:      time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return fmt.Errorf("Error waiting for the completion of the creating/updating of IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
@@ -201,6 +290,19 @@ func resourceIotHubDPSCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("Cannot read IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 	}
 
+	// Azure can provision a different capacity to the one requested (e.g. due to quota) - so that this doesn't show
+	// as a diff on the next plan, record the capacity which was provisioned in response to this request
+	if err := d.Set("sku", flattenIoTHubDPSSku(resp.Sku, []interface{}{
+		map[string]interface{}{
+			"capacity": d.Get("sku.0.capacity").(int),
+		},
+	})); err != nil {
+		return fmt.Errorf("Error setting `sku`: %+v", err)
+	}
+
+	// TODO: once Managed Identity is supported (which requires a newer Provisioning Services API than 2018-01-22),
+	// wait for the system-assigned `principal_id` to be populated and stable here, so that role assignments against
+	// it don't fail because the principal hasn't yet propagated
 	d.SetId(*resp.ID)
 
 	return resourceIotHubDPSRead(d, meta)
@@ -216,10 +318,9 @@ func resourceIotHubDPSRead(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 	resourceGroup := id.ResourceGroup
-	name := id.Path["provisioningServices"]
-	// the name path can use the ProvisioningServices in older iterations
-	if name == "" {
-		name = id.Path["ProvisioningServices"]
+	name, err := iotHubDPSNameFromResourceID(id)
+	if err != nil {
+		return err
 	}
 
 	resp, err := client.Get(ctx, name, resourceGroup)
@@ -237,7 +338,7 @@ func resourceIotHubDPSRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	if location := resp.Location; location != nil {
 		d.Set("location", azure.NormalizeLocation(*location))
 	}
-	sku := flattenIoTHubDPSSku(resp.Sku)
+	sku := flattenIoTHubDPSSku(resp.Sku, d.Get("sku").([]interface{}))
 	if err := d.Set("sku", sku); err != nil {
 		return fmt.Errorf("Error setting `sku`: %+v", err)
 	}
@@ -247,6 +348,16 @@ func resourceIotHubDPSRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			return fmt.Errorf("Error setting `linked_hub`: %+v", err)
 		}
 
+		linkedHubCount := 0
+		if props.IotHubs != nil {
+			linkedHubCount = len(*props.IotHubs)
+		}
+		d.Set("linked_hub_count", linkedHubCount)
+
+		if err := d.Set("linked_hub_locations", flattenIoTHubDPSLinkedHubLocations(props.IotHubs)); err != nil {
+			return fmt.Errorf("Error setting `linked_hub_locations`: %+v", err)
+		}
+
 		d.Set("service_operations_host_name", props.ServiceOperationsHostName)
 		d.Set("device_provisioning_host_name", props.DeviceProvisioningHostName)
 		d.Set("id_scope", props.IDScope)
@@ -266,26 +377,77 @@ func resourceIotHubDPSDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 	resourceGroup := id.ResourceGroup
+	name, err := iotHubDPSNameFromResourceID(id)
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(name, IothubResourceName)
+	defer locks.UnlockByName(name, IothubResourceName)
+
+	deleteFunc := func(ctx context.Context) (*http.Response, error) {
+		future, err := client.Delete(ctx, name, resourceGroup)
+		return future.Response(), err
+	}
+	if err := deleteIotHubDPS(ctx, deleteFunc, 30*time.Second); err != nil {
+		return fmt.Errorf("Error deleting IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	getFunc := func(ctx context.Context) (iothub.ProvisioningServiceDescription, error) {
+		return client.Get(ctx, name, resourceGroup)
+	}
+	return waitForIotHubDPSToBeDeleted(ctx, getFunc, resourceGroup, name, d.Timeout(pluginsdk.TimeoutDelete))
+}
+
+// iotHubDPSNameFromResourceID returns the name of the IoT Device Provisioning Service from it's Resource ID - which
+// can use the `ProvisioningServices` segment rather than `provisioningServices` in older iterations
+func iotHubDPSNameFromResourceID(id *azure.ResourceID) (string, error) {
 	name := id.Path["provisioningServices"]
+	if name == "" {
+		name = id.Path["ProvisioningServices"]
+	}
 
-	future, err := client.Delete(ctx, name, resourceGroup)
-	if err != nil {
-		if !response.WasNotFound(future.Response()) {
-			return fmt.Errorf("Error deleting IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
-		}
+	if name == "" {
+		return "", fmt.Errorf("ID was missing the `provisioningServices` element")
+	}
+
+	return name, nil
+}
+
+// deleteIotHubDPS deletes the IoT Device Provisioning Service, re-issuing the delete once should the first attempt return
+// a Conflict - which can happen whilst dependent resources (such as Enrollment Groups) are still being detached
+func deleteIotHubDPS(ctx context.Context, deleteFunc func(ctx context.Context) (*http.Response, error), retryDelay time.Duration) error {
+	resp, err := deleteFunc(ctx)
+	if err == nil || response.WasNotFound(resp) {
+		return nil
+	}
+	if !response.WasConflict(resp) {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting IoT Device Provisioning Service returned a Conflict - retrying in %s: %+v", retryDelay, err)
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("waiting to retry the delete: %+v", ctx.Err())
+	case <-time.After(retryDelay):
 	}
 
-	return waitForIotHubDPSToBeDeleted(ctx, client, resourceGroup, name, d)
+	resp, err = deleteFunc(ctx)
+	if err != nil && !response.WasNotFound(resp) {
+		return err
+	}
+
+	return nil
 }
 
-func waitForIotHubDPSToBeDeleted(ctx context.Context, client *iothub.IotDpsResourceClient, resourceGroup, name string, d *pluginsdk.ResourceData) error {
+func waitForIotHubDPSToBeDeleted(ctx context.Context, getFunc func(ctx context.Context) (iothub.ProvisioningServiceDescription, error), resourceGroup, name string, timeout time.Duration) error {
 	// we can't use the Waiter here since the API returns a 404 once it's deleted which is considered a polling status code..
 	log.Printf("[DEBUG] Waiting for IoT Device Provisioning Service %q (Resource Group %q) to be deleted", name, resourceGroup)
 	stateConf := &pluginsdk.StateChangeConf{
-		Pending: []string{"200"},
+		Pending: []string{"200", "Retrying"},
 		Target:  []string{"404"},
-		Refresh: iothubdpsStateStatusCodeRefreshFunc(ctx, client, resourceGroup, name),
-		Timeout: d.Timeout(pluginsdk.TimeoutDelete),
+		Refresh: iothubdpsStateStatusCodeRefreshFunc(ctx, getFunc, resourceGroup, name),
+		Timeout: timeout,
 	}
 
 	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
@@ -295,9 +457,9 @@ func waitForIotHubDPSToBeDeleted(ctx context.Context, client *iothub.IotDpsResou
 	return nil
 }
 
-func iothubdpsStateStatusCodeRefreshFunc(ctx context.Context, client *iothub.IotDpsResourceClient, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+func iothubdpsStateStatusCodeRefreshFunc(ctx context.Context, getFunc func(ctx context.Context) (iothub.ProvisioningServiceDescription, error), resourceGroup, name string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		res, err := client.Get(ctx, name, resourceGroup)
+		res, err := getFunc(ctx)
 
 		log.Printf("Retrieving IoT Device Provisioning Service %q (Resource Group %q) returned Status %d", resourceGroup, name, res.StatusCode)
 
@@ -305,6 +467,13 @@ func iothubdpsStateStatusCodeRefreshFunc(ctx context.Context, client *iothub.Iot
 			if utils.ResponseWasNotFound(res.Response) {
 				return res, strconv.Itoa(res.StatusCode), nil
 			}
+
+			// dependent resources can still be detaching, so transient failures (e.g. a conflict, throttling or a 5xx
+			// from the API) shouldn't abort the destroy - keep polling
+			if r := res.Response.Response; r != nil && (r.StatusCode == http.StatusConflict || r.StatusCode == http.StatusTooManyRequests || r.StatusCode >= http.StatusInternalServerError) {
+				log.Printf("[DEBUG] Retrying after transient error (Status Code %d) polling IoT Device Provisioning Service %q (Resource Group %q): %+v", r.StatusCode, name, resourceGroup, err)
+				return res, "Retrying", nil
+			}
 			return nil, "", fmt.Errorf("Error polling for the status of the IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
 		}
 
@@ -327,6 +496,7 @@ func expandIoTHubDPSIoTHubs(input []interface{}) *[]iothub.DefinitionDescription
 
 	for _, attr := range input {
 		linkedHubConfig := attr.(map[string]interface{})
+		// the `allocation_weight` is always sent, since `0` is meaningful (the Linked Hub won't receive any new devices)
 		linkedHub := iothub.DefinitionDescription{
 			ConnectionString:      utils.String(linkedHubConfig["connection_string"].(string)),
 			AllocationWeight:      utils.Int32(int32(linkedHubConfig["allocation_weight"].(int))),
@@ -340,15 +510,75 @@ func expandIoTHubDPSIoTHubs(input []interface{}) *[]iothub.DefinitionDescription
 	return &linkedHubs
 }
 
-func flattenIoTHubDPSSku(input *iothub.IotDpsSkuInfo) []interface{} {
-	output := make(map[string]interface{})
+func countIoTHubDPSLinkedHubsApplyingAllocationPolicy(input []interface{}) int {
+	count := 0
+	for _, attr := range input {
+		if linkedHub, ok := attr.(map[string]interface{}); ok && linkedHub["apply_allocation_policy"].(bool) {
+			count++
+		}
+	}
+	return count
+}
+
+// flattenIoTHubDPSSku flattens the SKU returned from the API. Since Azure can provision a different capacity to
+// the one requested, the requested capacity from `existing` is retained whilst the provisioned capacity is unchanged
+// since it was last read - and otherwise the provisioned capacity is used, so that changes made outside of Terraform
+// are still detected
+func flattenIoTHubDPSSku(input *iothub.IotDpsSkuInfo, existing []interface{}) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	effectiveCapacity := 0
+	if input.Capacity != nil {
+		effectiveCapacity = int(*input.Capacity)
+	}
+
+	capacity := effectiveCapacity
+	if len(existing) > 0 && existing[0] != nil {
+		raw := existing[0].(map[string]interface{})
+		existingCapacity, _ := raw["capacity"].(int)
+		existingEffectiveCapacity, ok := raw["effective_capacity"].(int)
+		if existingCapacity > 0 && (!ok || existingEffectiveCapacity == effectiveCapacity) {
+			capacity = existingCapacity
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":               string(input.Name),
+			"capacity":           capacity,
+			"effective_capacity": effectiveCapacity,
+		},
+	}
+}
+
+// flattenIoTHubDPSLinkedHubLocations returns the distinct locations of the linked IoT Hubs which the allocation policy
+// applies to, which are the locations considered when allocating devices using the `GeoLatency` allocation policy
+func flattenIoTHubDPSLinkedHubLocations(input *[]iothub.DefinitionDescription) []interface{} {
+	locations := make([]interface{}, 0)
+	if input == nil {
+		return locations
+	}
 
-	output["name"] = string(input.Name)
-	if capacity := input.Capacity; capacity != nil {
-		output["capacity"] = int(*capacity)
+	seen := make(map[string]struct{})
+	for _, attr := range *input {
+		if attr.ApplyAllocationPolicy != nil && !*attr.ApplyAllocationPolicy {
+			continue
+		}
+		if attr.Location == nil {
+			continue
+		}
+
+		loc := azure.NormalizeLocation(*attr.Location)
+		if _, ok := seen[loc]; ok {
+			continue
+		}
+		seen[loc] = struct{}{}
+		locations = append(locations, loc)
 	}
 
-	return []interface{}{output}
+	return locations
 }
 
 func flattenIoTHubDPSLinkedHub(input *[]iothub.DefinitionDescription) []interface{} {
@@ -366,9 +596,13 @@ func flattenIoTHubDPSLinkedHub(input *[]iothub.DefinitionDescription) []interfac
 		if attr.ApplyAllocationPolicy != nil {
 			linkedHub["apply_allocation_policy"] = *attr.ApplyAllocationPolicy
 		}
+		// an `allocation_weight` of `0` (meaning the Linked Hub doesn't receive any new devices) is omitted by the API,
+		// so this is always set to ensure this is read back as `0` rather than being left unset
+		allocationWeight := 0
 		if attr.AllocationWeight != nil {
-			linkedHub["allocation_weight"] = *attr.AllocationWeight
+			allocationWeight = int(*attr.AllocationWeight)
 		}
+		linkedHub["allocation_weight"] = allocationWeight
 		if attr.ConnectionString != nil {
 			linkedHub["connection_string"] = *attr.ConnectionString
 		}
@@ -381,3 +615,33 @@ func flattenIoTHubDPSLinkedHub(input *[]iothub.DefinitionDescription) []interfac
 
 	return linkedHubs
 }
+
+func iothubDPSCreateUpdateStateRefreshFunc(ctx context.Context, client *iothub.IotDpsResourceClient, future iothub.IotDpsResourceCreateOrUpdateFuture, resourceGroup, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		done, err := future.DoneWithContext(ctx, client)
+		if err != nil {
+			return nil, "", fmt.Errorf("polling the creation/update of IoT Device Provisioning Service %q (Resource Group %q): %+v", name, resourceGroup, err)
+		}
+
+		if done {
+			return future, "Completed", nil
+		}
+
+		// the state isn't used to determine completion, it's only logged to give an indication of progress
+		resp, err := client.Get(ctx, name, resourceGroup)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to retrieve IoT Device Provisioning Service %q (Resource Group %q) whilst waiting for it to be created/updated: %+v", name, resourceGroup, err)
+			return future, "InProgress", nil
+		}
+
+		if props := resp.Properties; props != nil {
+			provisioningState := ""
+			if props.ProvisioningState != nil {
+				provisioningState = *props.ProvisioningState
+			}
+			log.Printf("[DEBUG] Waiting for IoT Device Provisioning Service %q (Resource Group %q) to be created/updated - Provisioning State %q / State %q", name, resourceGroup, provisioningState, string(props.State))
+		}
+
+		return future, "InProgress", nil
+	}
+}
@@ -3,6 +3,7 @@ package iothub_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -84,6 +85,8 @@ func TestAccIotHubDPS_linkedHubs(t *testing.T) {
 			Config: r.linkedHubs(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_hub_count").HasValue("2"),
+				check.That(data.ResourceName).Key("linked_hub_locations.#").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
@@ -91,12 +94,89 @@ func TestAccIotHubDPS_linkedHubs(t *testing.T) {
 			Config: r.linkedHubsUpdated(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_hub_count").HasValue("1"),
 			),
 		},
 		data.ImportStep(),
 	})
 }
 
+func TestAccIotHubDPS_linkedHubsZeroAllocationWeight(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps", "test")
+	r := IotHubDPSResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedHubsZeroAllocationWeight(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_hub.0.allocation_weight").HasValue("0"),
+				check.That(data.ResourceName).Key("linked_hub.1.allocation_weight").HasValue("15"),
+			),
+		},
+		{
+			Config:   r.linkedHubsZeroAllocationWeight(data),
+			PlanOnly: true,
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccIotHubDPS_linkedHubsConcurrentWithSharedAccessPolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps", "test")
+	r := IotHubDPSResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedHubs(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// the Shared Access Policy doesn't reference the IoT Device Provisioning Service, so that it's
+			// created whilst the linked hubs are updated - which only succeeds when these are serialized
+			Config: r.linkedHubsUpdatedWithSharedAccessPolicy(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("linked_hub_count").HasValue("1"),
+				check.That("azurerm_iothub_dps_shared_access_policy.test").ExistsInAzure(IotHubDpsSharedAccessPolicyResource{}),
+			),
+		},
+	})
+}
+
+func TestAccIotHubDPS_linkedHubsStaticAllocationRemoval(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps", "test")
+	r := IotHubDPSResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.linkedHubsStaticAllocation(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.linkedHubsStaticAllocation(data, false),
+			ExpectError: regexp.MustCompile("at least one `linked_hub` must have `apply_allocation_policy` set to `true`"),
+		},
+	})
+}
+
+func TestAccIotHubDPS_linkedHubsExceedingLimit(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_dps", "test")
+	r := IotHubDPSResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.linkedHubsExceedingLimit(data),
+			ExpectError: regexp.MustCompile("a maximum of 50 `linked_hub` blocks can be specified"),
+		},
+	})
+}
+
 func (t IotHubDPSResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := azure.ParseAzureResourceID(state.ID)
 	if err != nil {
@@ -251,3 +331,117 @@ resource "azurerm_iothub_dps" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
 }
+
+func (IotHubDPSResource) linkedHubsZeroAllocationWeight(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iothub_dps" "test" {
+  name                = "acctestIoTDPS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  linked_hub {
+    connection_string       = "HostName=test.azure-devices.net;SharedAccessKeyName=iothubowner;SharedAccessKey=booo"
+    location                = azurerm_resource_group.test.location
+    allocation_weight       = 0
+    apply_allocation_policy = true
+  }
+
+  linked_hub {
+    connection_string       = "HostName=test2.azure-devices.net;SharedAccessKeyName=iothubowner2;SharedAccessKey=key2"
+    location                = azurerm_resource_group.test.location
+    allocation_weight       = 15
+    apply_allocation_policy = true
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r IotHubDPSResource) linkedHubsUpdatedWithSharedAccessPolicy(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_iothub_dps_shared_access_policy" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_dps_name     = "acctestIoTDPS-%d"
+  name                = "acctest"
+  service_config      = true
+}
+`, r.linkedHubsUpdated(data), data.RandomInteger)
+}
+
+func (IotHubDPSResource) linkedHubsStaticAllocation(data acceptance.TestData, applyAllocationPolicy bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iothub_dps" "test" {
+  name                = "acctestIoTDPS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  allocation_policy   = "Static"
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  linked_hub {
+    connection_string       = "HostName=test.azure-devices.net;SharedAccessKeyName=iothubowner;SharedAccessKey=booo"
+    location                = azurerm_resource_group.test.location
+    apply_allocation_policy = %t
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, applyAllocationPolicy)
+}
+
+func (IotHubDPSResource) linkedHubsExceedingLimit(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_iothub_dps" "test" {
+  name                = "acctestIoTDPS-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  dynamic "linked_hub" {
+    for_each = range(51)
+    content {
+      connection_string = "HostName=test${linked_hub.value}.azure-devices.net;SharedAccessKeyName=iothubowner;SharedAccessKey=booo"
+      location          = azurerm_resource_group.test.location
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,97 @@
+package iothub
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/provisioningservices/mgmt/2018-01-22/iothub"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestFlattenIoTHubDPSSku(t *testing.T) {
+	sku := func(capacity int64) *iothub.IotDpsSkuInfo {
+		return &iothub.IotDpsSkuInfo{
+			Name:     iothub.S1,
+			Capacity: utils.Int64(capacity),
+		}
+	}
+	expected := func(capacity, effectiveCapacity int) []interface{} {
+		return []interface{}{
+			map[string]interface{}{
+				"name":               "S1",
+				"capacity":           capacity,
+				"effective_capacity": effectiveCapacity,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name     string
+		Input    *iothub.IotDpsSkuInfo
+		Existing []interface{}
+		Expected []interface{}
+	}{
+		{
+			Name:     "nil SKU",
+			Input:    nil,
+			Expected: []interface{}{},
+		},
+		{
+			Name:     "import",
+			Input:    sku(2),
+			Existing: []interface{}{},
+			Expected: expected(2, 2),
+		},
+		{
+			Name:  "provisioned capacity matches the requested capacity",
+			Input: sku(2),
+			Existing: []interface{}{
+				map[string]interface{}{
+					"capacity": 2,
+				},
+			},
+			Expected: expected(2, 2),
+		},
+		{
+			Name:  "provisioned capacity differs from the requested capacity after an update",
+			Input: sku(1),
+			Existing: []interface{}{
+				map[string]interface{}{
+					"capacity": 2,
+				},
+			},
+			Expected: expected(2, 1),
+		},
+		{
+			Name:  "provisioned capacity still differs from the requested capacity",
+			Input: sku(1),
+			Existing: []interface{}{
+				map[string]interface{}{
+					"capacity":           2,
+					"effective_capacity": 1,
+				},
+			},
+			Expected: expected(2, 1),
+		},
+		{
+			Name:  "provisioned capacity changed outside of Terraform",
+			Input: sku(3),
+			Existing: []interface{}{
+				map[string]interface{}{
+					"capacity":           2,
+					"effective_capacity": 1,
+				},
+			},
+			Expected: expected(3, 3),
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		actual := flattenIoTHubDPSSku(v.Input, v.Existing)
+		if !reflect.DeepEqual(actual, v.Expected) {
+			t.Fatalf("Expected %+v but got %+v", v.Expected, actual)
+		}
+	}
+}
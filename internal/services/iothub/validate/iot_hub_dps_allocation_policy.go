@@ -0,0 +1,37 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/provisioningservices/mgmt/2018-01-22/iothub"
+)
+
+// IoTHubDPSAllocationPolicy accepts the allocation policies known to this provider, and warns rather than
+// errors for any other value so that allocation policies introduced in preview API versions can still be used
+func IoTHubDPSAllocationPolicy(v interface{}, k string) (warnings []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return warnings, errors
+	}
+
+	if strings.TrimSpace(value) == "" {
+		errors = append(errors, fmt.Errorf("%q must not be empty", k))
+		return warnings, errors
+	}
+
+	knownPolicies := []string{
+		string(iothub.Hashed),
+		string(iothub.GeoLatency),
+		string(iothub.Static),
+	}
+	for _, policy := range knownPolicies {
+		if value == policy {
+			return warnings, errors
+		}
+	}
+
+	warnings = append(warnings, fmt.Sprintf("%q is set to %q which isn't one of the known values (%s) - this may be a preview allocation policy which isn't supported in all regions", k, value, strings.Join(knownPolicies, ", ")))
+	return warnings, errors
+}
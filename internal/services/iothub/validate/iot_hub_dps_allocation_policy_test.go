@@ -0,0 +1,46 @@
+package validate
+
+import "testing"
+
+func TestIoTHubDPSAllocationPolicy(t *testing.T) {
+	cases := []struct {
+		Input        string
+		ExpectWarn   bool
+		ExpectErrors bool
+	}{
+		{
+			Input:        "",
+			ExpectErrors: true,
+		},
+		{
+			Input: "Hashed",
+		},
+		{
+			Input: "GeoLatency",
+		},
+		{
+			Input: "Static",
+		},
+		{
+			Input:      "Symmetric",
+			ExpectWarn: true,
+		},
+		{
+			Input:      "hashed",
+			ExpectWarn: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %q", tc.Input)
+		warnings, errors := IoTHubDPSAllocationPolicy(tc.Input, "allocation_policy")
+
+		if tc.ExpectErrors != (len(errors) > 0) {
+			t.Fatalf("expected errors to be %t for %q but got %+v", tc.ExpectErrors, tc.Input, errors)
+		}
+
+		if tc.ExpectWarn != (len(warnings) > 0) {
+			t.Fatalf("expected warnings to be %t for %q but got %+v", tc.ExpectWarn, tc.Input, warnings)
+		}
+	}
+}
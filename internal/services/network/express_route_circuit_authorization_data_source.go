@@ -0,0 +1,138 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceExpressRouteCircuitAuthorizationRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"express_route_circuit_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ExpressRouteCircuitName,
+			},
+
+			"wait_for_use_status": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AuthorizationUseStatusAvailable),
+					string(network.AuthorizationUseStatusInUse),
+				}, false),
+			},
+
+			"authorization_key": {
+				Type:      pluginsdk.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"authorization_use_status": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceExpressRouteCircuitAuthorizationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	circuitName := d.Get("express_route_circuit_name").(string)
+
+	resp, err := client.Get(ctx, resourceGroup, circuitName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Error: Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) was not found", name, circuitName, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+	}
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("Error retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): `id` was nil", name, circuitName, resourceGroup)
+	}
+
+	if expected := d.Get("wait_for_use_status").(string); expected != "" {
+		deadline, ok := ctx.Deadline()
+		if !ok {
+			return fmt.Errorf("context had no deadline")
+		}
+
+		log.Printf("[DEBUG] Waiting for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) to have a use status of %q", name, circuitName, resourceGroup, expected)
+		pending := make([]string, 0)
+		for _, status := range network.PossibleAuthorizationUseStatusValues() {
+			if string(status) != expected {
+				pending = append(pending, string(status))
+			}
+		}
+		stateConf := &pluginsdk.StateChangeConf{
+			// the use status can be omitted whilst the Authorization is being provisioned
+			Pending:      append(pending, ""),
+			Target:       []string{expected},
+			Refresh:      expressRouteCircuitAuthorizationUseStatusRefreshFunc(ctx, client, resourceGroup, circuitName, name),
+			PollInterval: 15 * time.Second,
+			Timeout:      time.Until(deadline),
+		}
+
+		readRaw, err := stateConf.WaitForStateContext(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) to have a use status of %q: %+v", name, circuitName, resourceGroup, expected, err)
+		}
+		resp = readRaw.(network.ExpressRouteCircuitAuthorization)
+	}
+
+	d.SetId(*resp.ID)
+
+	if props := resp.AuthorizationPropertiesFormat; props != nil {
+		d.Set("authorization_key", props.AuthorizationKey)
+		d.Set("authorization_use_status", string(props.AuthorizationUseStatus))
+	}
+
+	return nil
+}
+
+func expressRouteCircuitAuthorizationUseStatusRefreshFunc(ctx context.Context, client *network.ExpressRouteCircuitAuthorizationsClient, resourceGroup, circuitName, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, resourceGroup, circuitName, name)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+		}
+
+		useStatus := ""
+		if props := res.AuthorizationPropertiesFormat; props != nil {
+			useStatus = string(props.AuthorizationUseStatus)
+		}
+
+		return res, useStatus, nil
+	}
+}
@@ -1,7 +1,9 @@
 package network
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
@@ -10,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -19,9 +24,13 @@ func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceExpressRouteCircuitAuthorizationCreate,
 		Read:   resourceExpressRouteCircuitAuthorizationRead,
+		Update: resourceExpressRouteCircuitAuthorizationUpdate,
 		Delete: resourceExpressRouteCircuitAuthorizationDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ExpressRouteCircuitAuthorizationID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -40,9 +49,10 @@ func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 			"resource_group_name": azure.SchemaResourceGroupName(),
 
 			"express_route_circuit_name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ExpressRouteCircuitName,
 			},
 
 			"authorization_key": {
@@ -55,12 +65,62 @@ func resourceExpressRouteCircuitAuthorization() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
 			},
+
+			"provisioning_state": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"expected_use_status": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.AuthorizationUseStatusAvailable),
+					string(network.AuthorizationUseStatusInUse),
+				}, false),
+			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			pluginsdk.CustomizeDiffShim(expressRouteCircuitAuthorizationRenameCustomizeDiff),
+			pluginsdk.CustomizeDiffShim(expressRouteCircuitAuthorizationUseStatusCustomizeDiff),
+		),
 	}
 }
 
+func expressRouteCircuitAuthorizationRenameCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("name") {
+		return nil
+	}
+
+	// an Authorization can't be renamed in-place, and re-creating it generates a new Authorization Key - which would
+	// break any Connection currently using the existing key
+	oldName, newName := d.GetChange("name")
+	if d.Get("authorization_use_status").(string) == string(network.AuthorizationUseStatusInUse) {
+		return fmt.Errorf("renaming the Express Route Circuit Authorization %q to %q requires re-creating it, which would invalidate the Authorization Key that is currently in use - please remove the Connection using this Authorization first", oldName.(string), newName.(string))
+	}
+
+	return nil
+}
+
+func expressRouteCircuitAuthorizationUseStatusCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	expected := d.Get("expected_use_status").(string)
+	if d.Id() == "" || expected == "" {
+		return nil
+	}
+
+	// surface an unexpected change in the use status (e.g. the key being consumed outside of Terraform) as a diff - this
+	// can't be resolved by an apply, only by the use status changing in Azure (or `expected_use_status` being updated)
+	if actual := d.Get("authorization_use_status").(string); actual != expected {
+		return d.SetNew("authorization_use_status", expected)
+	}
+
+	return nil
+}
+
 func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	circuitsClient := meta.(*clients.Client).Network.ExpressRouteCircuitsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -71,6 +131,16 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 	locks.ByName(circuitName, expressRouteCircuitResourceName)
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
 
+	// the circuit is commonly provisioned in the same apply, so this is checked here rather than at plan time
+	circuit, err := circuitsClient.Get(ctx, resourceGroup, circuitName)
+	if err != nil {
+		if utils.ResponseWasNotFound(circuit.Response) {
+			return fmt.Errorf("the Express Route Circuit %q was not found in Resource Group %q - please ensure `express_route_circuit_name` refers to an existing Express Route Circuit", circuitName, resourceGroup)
+		}
+
+		return fmt.Errorf("Error retrieving Express Route Circuit %q (Resource Group %q): %+v", circuitName, resourceGroup, err)
+	}
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, circuitName, name)
 		if err != nil {
@@ -97,9 +167,28 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 		return fmt.Errorf("Error waiting for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) to finish creating/updating: %+v", name, circuitName, resourceGroup, err)
 	}
 
-	read, err := client.Get(ctx, resourceGroup, circuitName, name)
+	// the Authorization Key isn't necessarily usable (or even returned) once the operation completes, so wait for
+	// the Authorization to finish provisioning and for the Authorization Key to be available
+	log.Printf("[DEBUG] Waiting for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) to finish provisioning", name, circuitName, resourceGroup)
+	getFunc := func(ctx context.Context) (network.ExpressRouteCircuitAuthorization, error) {
+		return client.Get(ctx, resourceGroup, circuitName, name)
+	}
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending:      []string{string(network.ProvisioningStateUpdating), expressRouteCircuitAuthorizationWaitingForKeyState},
+		Target:       []string{string(network.ProvisioningStateSucceeded)},
+		Refresh:      expressRouteCircuitAuthorizationProvisioningStateRefreshFunc(ctx, getFunc, resourceGroup, circuitName, name),
+		PollInterval: 10 * time.Second,
+		Timeout:      d.Timeout(pluginsdk.TimeoutCreate),
+	}
+
+	readRaw, err := stateConf.WaitForStateContext(ctx)
 	if err != nil {
-		return fmt.Errorf("Error retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+		return fmt.Errorf("waiting for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) to finish provisioning: %+v", name, circuitName, resourceGroup, err)
+	}
+
+	read := readRaw.(network.ExpressRouteCircuitAuthorization)
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) ID", name, circuitName, resourceGroup)
 	}
 
 	d.SetId(*read.ID)
@@ -107,19 +196,25 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 	return resourceExpressRouteCircuitAuthorizationRead(d, meta)
 }
 
+func resourceExpressRouteCircuitAuthorizationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// only `expected_use_status` can be updated, which isn't sent to the API - so there's nothing to do other than
+	// to re-read the Authorization, which surfaces the actual use status again
+	return resourceExpressRouteCircuitAuthorizationRead(d, meta)
+}
+
 func resourceExpressRouteCircuitAuthorizationRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ExpressRouteCircuitAuthorizationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName := id.ExpressRouteCircuitName
+	name := id.AuthorizationName
 
 	resp, err := client.Get(ctx, resourceGroup, circuitName, name)
 	if err != nil {
@@ -137,6 +232,7 @@ func resourceExpressRouteCircuitAuthorizationRead(d *pluginsdk.ResourceData, met
 	if props := resp.AuthorizationPropertiesFormat; props != nil {
 		d.Set("authorization_key", props.AuthorizationKey)
 		d.Set("authorization_use_status", string(props.AuthorizationUseStatus))
+		d.Set("provisioning_state", string(props.ProvisioningState))
 	}
 
 	return nil
@@ -147,14 +243,14 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, m
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := azure.ParseAzureResourceID(d.Id())
+	id, err := parse.ExpressRouteCircuitAuthorizationID(d.Id())
 	if err != nil {
 		return err
 	}
 
 	resourceGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
+	circuitName := id.ExpressRouteCircuitName
+	name := id.AuthorizationName
 
 	locks.ByName(circuitName, expressRouteCircuitResourceName)
 	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
@@ -178,3 +274,35 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, m
 
 	return nil
 }
+
+// expressRouteCircuitAuthorizationWaitingForKeyState is the pending state used whilst the Authorization has finished
+// provisioning but the Authorization Key hasn't been returned yet
+const expressRouteCircuitAuthorizationWaitingForKeyState = "WaitingForAuthorizationKey"
+
+func expressRouteCircuitAuthorizationProvisioningStateRefreshFunc(ctx context.Context, getFunc func(ctx context.Context) (network.ExpressRouteCircuitAuthorization, error), resourceGroup, circuitName, name string) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := getFunc(ctx)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): %+v", name, circuitName, resourceGroup, err)
+		}
+
+		if res.AuthorizationPropertiesFormat == nil {
+			return nil, "", fmt.Errorf("retrieving Express Route Circuit Authorization %q (Circuit %q / Resource Group %q): `properties` was nil", name, circuitName, resourceGroup)
+		}
+
+		state := res.AuthorizationPropertiesFormat.ProvisioningState
+		if state == network.ProvisioningStateFailed {
+			return res, string(state), fmt.Errorf("the Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) failed to provision", name, circuitName, resourceGroup)
+		}
+
+		// the Authorization Key can briefly be omitted after provisioning has completed
+		if state == network.ProvisioningStateSucceeded {
+			if key := res.AuthorizationPropertiesFormat.AuthorizationKey; key == nil || *key == "" {
+				log.Printf("[DEBUG] Authorization Key for Express Route Circuit Authorization %q (Circuit %q / Resource Group %q) isn't available yet", name, circuitName, resourceGroup)
+				return res, expressRouteCircuitAuthorizationWaitingForKeyState, nil
+			}
+		}
+
+		return res, string(state), nil
+	}
+}
@@ -3,12 +3,13 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
-	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -26,6 +27,7 @@ func testAccExpressRouteCircuitAuthorization_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
 				check.That(data.ResourceName).Key("authorization_key").Exists(),
+				check.That(data.ResourceName).Key("provisioning_state").HasValue("Succeeded"),
 			),
 		},
 		data.ImportStep(),
@@ -68,18 +70,49 @@ func testAccExpressRouteCircuitAuthorization_multiple(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteCircuitAuthorization_circuitNotFound(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_authorization", "test")
+	r := ExpressRouteCircuitAuthorizationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.circuitNotFoundConfig(data),
+			ExpectError: regexp.MustCompile("the Express Route Circuit \"acctest-erc-missing\" was not found"),
+		},
+	})
+}
+
+func testAccExpressRouteCircuitAuthorization_expectedUseStatus(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_authorization", "test")
+	r := ExpressRouteCircuitAuthorizationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.expectedUseStatusConfig(data, "Available"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("authorization_use_status").HasValue("Available"),
+			),
+		},
+		data.ImportStep("expected_use_status"),
+		{
+			// the key hasn't been consumed, so expecting it to be in use should show up as drift
+			Config:             r.expectedUseStatusConfig(data, "InUse"),
+			PlanOnly:           true,
+			ExpectNonEmptyPlan: true,
+		},
+	})
+}
+
 func (t ExpressRouteCircuitAuthorizationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := azure.ParseAzureResourceID(state.ID)
+	id, err := parse.ExpressRouteCircuitAuthorizationID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resGroup := id.ResourceGroup
-	circuitName := id.Path["expressRouteCircuits"]
-	name := id.Path["authorizations"]
 
-	resp, err := clients.Network.ExpressRouteAuthsClient.Get(ctx, resGroup, circuitName, name)
+	resp, err := clients.Network.ExpressRouteAuthsClient.Get(ctx, id.ResourceGroup, id.ExpressRouteCircuitName, id.AuthorizationName)
 	if err != nil {
-		return nil, fmt.Errorf("reading Express Route Circuit Authorization (%s): %+v", id, err)
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
 	}
 
 	return utils.Bool(resp.ID != nil), nil
@@ -125,6 +158,42 @@ resource "azurerm_express_route_circuit_authorization" "test" {
 `, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger)
 }
 
+func (ExpressRouteCircuitAuthorizationResource) expectedUseStatusConfig(data acceptance.TestData, expectedUseStatus string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_express_route_circuit" "test" {
+  name                  = "acctest-erc-%[1]d"
+  location              = azurerm_resource_group.test.location
+  resource_group_name   = azurerm_resource_group.test.name
+  service_provider_name = "Equinix"
+  peering_location      = "Silicon Valley"
+  bandwidth_in_mbps     = 50
+
+  sku {
+    tier   = "Standard"
+    family = "MeteredData"
+  }
+
+  allow_classic_operations = false
+}
+
+resource "azurerm_express_route_circuit_authorization" "test" {
+  name                       = "acctestauth%[1]d"
+  express_route_circuit_name = azurerm_express_route_circuit.test.name
+  resource_group_name        = azurerm_resource_group.test.name
+  expected_use_status        = "%[3]s"
+}
+`, data.RandomInteger, data.Locations.Primary, expectedUseStatus)
+}
+
 func (r ExpressRouteCircuitAuthorizationResource) requiresImportConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -137,6 +206,25 @@ resource "azurerm_express_route_circuit_authorization" "import" {
 `, r.basicConfig(data))
 }
 
+func (ExpressRouteCircuitAuthorizationResource) circuitNotFoundConfig(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_express_route_circuit_authorization" "test" {
+  name                       = "acctestauth%d"
+  express_route_circuit_name = "acctest-erc-missing"
+  resource_group_name        = azurerm_resource_group.test.name
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (ExpressRouteCircuitAuthorizationResource) multipleConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -0,0 +1,99 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func TestExpressRouteCircuitAuthorizationProvisioningStateRefreshFunc(t *testing.T) {
+	authorization := func(state network.ProvisioningState, key *string) network.ExpressRouteCircuitAuthorization {
+		return network.ExpressRouteCircuitAuthorization{
+			ID: utils.String("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/expressRouteCircuits/circuit1/authorizations/authorization1"),
+			AuthorizationPropertiesFormat: &network.AuthorizationPropertiesFormat{
+				AuthorizationKey:  key,
+				ProvisioningState: state,
+			},
+		}
+	}
+
+	cases := []struct {
+		Name          string
+		Responses     []network.ExpressRouteCircuitAuthorization
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name: "Key available immediately",
+			Responses: []network.ExpressRouteCircuitAuthorization{
+				authorization(network.ProvisioningStateSucceeded, utils.String("some-key")),
+			},
+			ExpectedCalls: 1,
+		},
+		{
+			Name: "Key delayed after provisioning",
+			Responses: []network.ExpressRouteCircuitAuthorization{
+				authorization(network.ProvisioningStateUpdating, nil),
+				authorization(network.ProvisioningStateSucceeded, nil),
+				authorization(network.ProvisioningStateSucceeded, utils.String("")),
+				authorization(network.ProvisioningStateSucceeded, utils.String("some-key")),
+			},
+			ExpectedCalls: 4,
+		},
+		{
+			Name: "Provisioning failed",
+			Responses: []network.ExpressRouteCircuitAuthorization{
+				authorization(network.ProvisioningStateSucceeded, nil),
+				authorization(network.ProvisioningStateFailed, nil),
+			},
+			ExpectedCalls: 2,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := 0
+		getFunc := func(ctx context.Context) (network.ExpressRouteCircuitAuthorization, error) {
+			if calls >= len(v.Responses) {
+				return network.ExpressRouteCircuitAuthorization{}, fmt.Errorf("unexpected call %d", calls+1)
+			}
+			resp := v.Responses[calls]
+			calls++
+			return resp, nil
+		}
+
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:      []string{string(network.ProvisioningStateUpdating), expressRouteCircuitAuthorizationWaitingForKeyState},
+			Target:       []string{string(network.ProvisioningStateSucceeded)},
+			Refresh:      expressRouteCircuitAuthorizationProvisioningStateRefreshFunc(context.TODO(), getFunc, "group1", "circuit1", "authorization1"),
+			PollInterval: time.Millisecond,
+			Timeout:      time.Minute,
+		}
+
+		result, err := stateConf.WaitForStateContext(context.TODO())
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if calls != v.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %d", v.ExpectedCalls, calls)
+		}
+
+		if !v.ExpectError {
+			read := result.(network.ExpressRouteCircuitAuthorization)
+			if key := read.AuthorizationPropertiesFormat.AuthorizationKey; key == nil || *key == "" {
+				t.Fatalf("Expected the Authorization Key to be set but it wasn't")
+			}
+		}
+	}
+}
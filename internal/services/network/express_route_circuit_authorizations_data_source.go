@@ -0,0 +1,112 @@
+package network
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceExpressRouteCircuitAuthorizations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceExpressRouteCircuitAuthorizationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"express_route_circuit_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ExpressRouteCircuitName,
+			},
+
+			"authorizations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"authorization_key": {
+							Type:      pluginsdk.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+
+						"authorization_use_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceExpressRouteCircuitAuthorizationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	client := meta.(*clients.Client).Network.ExpressRouteAuthsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := parse.NewExpressRouteCircuitID(subscriptionId, d.Get("resource_group_name").(string), d.Get("express_route_circuit_name").(string))
+
+	authorizations := make([]network.ExpressRouteCircuitAuthorization, 0)
+	for iterator, err := client.ListComplete(ctx, id.ResourceGroup, id.Name); iterator.NotDone(); err = iterator.NextWithContext(ctx) {
+		if err != nil {
+			return fmt.Errorf("listing Authorizations for %s: %+v", id, err)
+		}
+
+		authorizations = append(authorizations, iterator.Value())
+	}
+
+	d.SetId(id.ID())
+
+	if err := d.Set("authorizations", flattenExpressRouteCircuitAuthorizations(authorizations)); err != nil {
+		return fmt.Errorf("setting `authorizations`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenExpressRouteCircuitAuthorizations(input []network.ExpressRouteCircuitAuthorization) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		name := ""
+		if item.Name != nil {
+			name = *item.Name
+		}
+
+		authorizationKey := ""
+		authorizationUseStatus := ""
+		if props := item.AuthorizationPropertiesFormat; props != nil {
+			if props.AuthorizationKey != nil {
+				authorizationKey = *props.AuthorizationKey
+			}
+			authorizationUseStatus = string(props.AuthorizationUseStatus)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                     name,
+			"authorization_key":        authorizationKey,
+			"authorization_use_status": authorizationUseStatus,
+		})
+	}
+
+	return results
+}
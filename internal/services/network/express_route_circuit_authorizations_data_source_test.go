@@ -0,0 +1,46 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ExpressRouteCircuitAuthorizationsDataSource struct {
+}
+
+func testAccDataSourceExpressRouteCircuitAuthorizations_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_express_route_circuit_authorizations", "test")
+	r := ExpressRouteCircuitAuthorizationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("authorizations.#").HasValue("2"),
+				check.That(data.ResourceName).Key("authorizations.0.authorization_key").Exists(),
+				check.That(data.ResourceName).Key("authorizations.0.authorization_use_status").HasValue("Available"),
+				check.That(data.ResourceName).Key("authorizations.1.authorization_key").Exists(),
+				check.That(data.ResourceName).Key("authorizations.1.authorization_use_status").HasValue("Available"),
+			),
+		},
+	})
+}
+
+func (ExpressRouteCircuitAuthorizationsDataSource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_express_route_circuit_authorizations" "test" {
+  express_route_circuit_name = azurerm_express_route_circuit.test.name
+  resource_group_name        = azurerm_resource_group.test.name
+
+  depends_on = [
+    azurerm_express_route_circuit_authorization.test1,
+    azurerm_express_route_circuit_authorization.test2,
+  ]
+}
+`, ExpressRouteCircuitAuthorizationResource{}.multipleConfig(data))
+}
@@ -49,9 +49,14 @@ func TestAccExpressRouteCircuit(t *testing.T) {
 			"microsoftPeeringIpv6WithRouteFilter": testAccExpressRouteCircuitPeering_microsoftPeeringIpv6WithRouteFilter,
 		},
 		"authorization": {
-			"basic":          testAccExpressRouteCircuitAuthorization_basic,
-			"multiple":       testAccExpressRouteCircuitAuthorization_multiple,
-			"requiresImport": testAccExpressRouteCircuitAuthorization_requiresImport,
+			"basic":                 testAccExpressRouteCircuitAuthorization_basic,
+			"circuitNotFound":       testAccExpressRouteCircuitAuthorization_circuitNotFound,
+			"data_basic":            testAccDataSourceExpressRouteCircuitAuthorization_basic,
+			"data_multiple":         testAccDataSourceExpressRouteCircuitAuthorizations_multiple,
+			"data_waitForUseStatus": testAccDataSourceExpressRouteCircuitAuthorization_waitForUseStatus,
+			"expectedUseStatus":     testAccExpressRouteCircuitAuthorization_expectedUseStatus,
+			"multiple":              testAccExpressRouteCircuitAuthorization_multiple,
+			"requiresImport":        testAccExpressRouteCircuitAuthorization_requiresImport,
 		},
 	}
 
@@ -120,7 +120,9 @@ func mapFieldsToNetworkInterface(input *[]network.InterfaceIPConfiguration, info
 			continue
 		}
 
-		if config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddressVersion != network.IPVersionIPv4 {
+		// these can only be associated with IPv4 IP Configurations - however the API can omit the version for
+		// secondary IP Configurations, in which case it's IPv4
+		if config.InterfaceIPConfigurationPropertiesFormat.PrivateIPAddressVersion == network.IPVersionIPv6 {
 			continue
 		}
 
@@ -1,11 +1,14 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-11-01/network"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -21,8 +24,8 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociation() *pluginsdk.Re
 		Create: resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate,
 		Read:   resourceNetworkInterfaceApplicationSecurityGroupAssociationRead,
 		Delete: resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+
+		Importer: pluginsdk.ImporterValidatingResourceId(validateNetworkInterfaceApplicationSecurityGroupAssociationID),
 
 		SchemaVersion: 1,
 		StateUpgraders: pluginsdk.StateUpgrades(map[int]pluginsdk.StateUpgrade{
@@ -54,6 +57,23 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociation() *pluginsdk.Re
 	}
 }
 
+func validateNetworkInterfaceApplicationSecurityGroupAssociationID(id string) error {
+	splitId := strings.Split(id, "|")
+	if len(splitId) != 2 {
+		return fmt.Errorf("expected ID to be in the format {networkInterfaceId}|{applicationSecurityGroupId} but got %q", id)
+	}
+
+	if _, err := azure.ParseAzureResourceID(splitId[0]); err != nil {
+		return fmt.Errorf("parsing Network Interface ID %q from %q: %+v", splitId[0], id, err)
+	}
+
+	if _, err := azure.ParseAzureResourceID(splitId[1]); err != nil {
+		return fmt.Errorf("parsing Application Security Group ID %q from %q: %+v", splitId[1], id, err)
+	}
+
+	return nil
+}
+
 func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
@@ -75,42 +95,17 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(read.Response) {
-			log.Printf("[INFO] Network Interface %q does not exist - removing from state", d.Id())
-			d.SetId("")
-			return nil
-		}
-
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
-	}
-
-	props := read.InterfacePropertiesFormat
-	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
-	}
-	if props.IPConfigurations == nil {
-		return fmt.Errorf("Error: `properties.ipConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
-	}
-
-	info := parseFieldsFromNetworkInterface(*props)
 	resourceId := fmt.Sprintf("%s|%s", networkInterfaceId, applicationSecurityGroupId)
-	if utils.SliceContainsValue(info.applicationSecurityGroupIDs, applicationSecurityGroupId) {
-		return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_association", resourceId)
-	}
-
-	info.applicationSecurityGroupIDs = append(info.applicationSecurityGroupIDs, applicationSecurityGroupId)
-
-	read.InterfacePropertiesFormat.IPConfigurations = mapFieldsToNetworkInterface(props.IPConfigurations, info)
+	err = updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx, client, resourceGroup, networkInterfaceName, d.Timeout(pluginsdk.TimeoutCreate), func(info *networkInterfaceUpdateInformation) error {
+		if networkInterfaceApplicationSecurityGroupIDsContain(info.applicationSecurityGroupIDs, applicationSecurityGroupId) {
+			return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_association", resourceId)
+		}
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+		info.applicationSecurityGroupIDs = append(info.applicationSecurityGroupIDs, applicationSecurityGroupId)
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("Error updating Application Security Group Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
-	}
-
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for completion of Application Security Group Association for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		return err
 	}
 
 	d.SetId(resourceId)
@@ -154,13 +149,10 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationRead(d *pluginsd
 	}
 
 	info := parseFieldsFromNetworkInterface(*nicProps)
-	exists := false
-	for _, groupId := range info.applicationSecurityGroupIDs {
-		if groupId == applicationSecurityGroupId {
-			exists = true
-		}
-	}
+	exists := networkInterfaceApplicationSecurityGroupIDsContain(info.applicationSecurityGroupIDs, applicationSecurityGroupId)
 
+	// when the Application Security Group has been removed from the Network Interface outside of Terraform, removing
+	// this from the state means the association is re-created on the next apply
 	if !exists {
 		log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and Application Security Group %q was not found - removing from state!", networkInterfaceName, resourceGroup, applicationSecurityGroupId)
 		d.SetId("")
@@ -195,43 +187,94 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
 	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
 
-	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
-	if err != nil {
-		if utils.ResponseWasNotFound(read.Response) {
-			return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+	return updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx, client, resourceGroup, networkInterfaceName, d.Timeout(pluginsdk.TimeoutDelete), func(info *networkInterfaceUpdateInformation) error {
+		info.applicationSecurityGroupIDs = networkInterfaceApplicationSecurityGroupIDsWithout(info.applicationSecurityGroupIDs, applicationSecurityGroupId)
+		return nil
+	})
+}
+
+// updateNetworkInterfaceApplicationSecurityGroupAssociation retrieves the Network Interface, applies `mutate` to the
+// associations and then updates the Network Interface. Whilst each association locks on the Network Interface name,
+// these can still be modified concurrently outside of this provider instance (e.g. from another workspace), so this
+// re-reads the Network Interface and re-applies the change when the update fails due to a conflicting change.
+func updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx context.Context, client *network.InterfacesClient, resourceGroup, networkInterfaceName string, timeout time.Duration, mutate func(info *networkInterfaceUpdateInformation) error) error {
+	return retryNetworkInterfaceUpdate(timeout, func() (*http.Response, error) {
+		read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return nil, fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			}
+
+			return nil, fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
 		}
 
-		return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
-	}
+		props := read.InterfacePropertiesFormat
+		if props == nil {
+			return nil, fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		}
+		if props.IPConfigurations == nil {
+			return nil, fmt.Errorf("Error: `properties.ipConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
+		}
 
-	props := read.InterfacePropertiesFormat
-	if props == nil {
-		return fmt.Errorf("Error: `properties` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
-	}
+		info := parseFieldsFromNetworkInterface(*props)
+		if err := mutate(&info); err != nil {
+			return nil, err
+		}
+		read.InterfacePropertiesFormat.IPConfigurations = mapFieldsToNetworkInterface(props.IPConfigurations, info)
 
-	if props.IPConfigurations == nil {
-		return fmt.Errorf("Error: `properties.ipConfigurations` was nil for Network Interface %q (Resource Group %q)", networkInterfaceName, resourceGroup)
-	}
+		future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
+		if err != nil {
+			return future.Response(), fmt.Errorf("Error updating Application Security Group Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		}
 
-	info := parseFieldsFromNetworkInterface(*props)
+		if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return future.Response(), fmt.Errorf("Error waiting for update of Application Security Group Association for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		}
+
+		return nil, nil
+	})
+}
 
-	applicationSecurityGroupIds := make([]string, 0)
-	for _, v := range info.applicationSecurityGroupIDs {
-		if v != applicationSecurityGroupId {
-			applicationSecurityGroupIds = append(applicationSecurityGroupIds, v)
+// retryNetworkInterfaceUpdate calls `updateFunc` until it succeeds, retrying only when the update of the Network
+// Interface failed due to a conflicting change - any other error (or an error without a response) isn't retried
+func retryNetworkInterfaceUpdate(timeout time.Duration, updateFunc func() (*http.Response, error)) error {
+	return pluginsdk.Retry(timeout, func() *pluginsdk.RetryError {
+		resp, err := updateFunc()
+		if err == nil {
+			return nil
 		}
-	}
-	info.applicationSecurityGroupIDs = applicationSecurityGroupIds
-	read.InterfacePropertiesFormat.IPConfigurations = mapFieldsToNetworkInterface(props.IPConfigurations, info)
 
-	future, err := client.CreateOrUpdate(ctx, resourceGroup, networkInterfaceName, read)
-	if err != nil {
-		return fmt.Errorf("Error removing Application Security Group for Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		if networkInterfaceUpdateWasConflict(resp) {
+			return pluginsdk.RetryableError(err)
+		}
+		return pluginsdk.NonRetryableError(err)
+	})
+}
+
+// networkInterfaceApplicationSecurityGroupIDsContain returns whether `id` is within `ids` - the casing of the IDs
+// returned from the API can differ to the one used when associating the Application Security Group
+func networkInterfaceApplicationSecurityGroupIDsContain(ids []string, id string) bool {
+	for _, v := range ids {
+		if strings.EqualFold(v, id) {
+			return true
+		}
 	}
 
-	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
-		return fmt.Errorf("Error waiting for removal of Application Security Group for NIC %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+	return false
+}
+
+// networkInterfaceApplicationSecurityGroupIDsWithout returns `ids` without any (case-insensitive) occurrences of `id`
+func networkInterfaceApplicationSecurityGroupIDsWithout(ids []string, id string) []string {
+	results := make([]string, 0)
+	for _, v := range ids {
+		if !strings.EqualFold(v, id) {
+			results = append(results, v)
+		}
 	}
 
-	return nil
+	return results
+}
+
+func networkInterfaceUpdateWasConflict(resp *http.Response) bool {
+	return resp != nil && (resp.StatusCode == http.StatusConflict || resp.StatusCode == http.StatusPreconditionFailed)
 }
@@ -3,6 +3,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -40,6 +41,7 @@ func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_multipleIPConfig
 			Config: r.multipleIPConfigurations(data),
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.associatedWithAllIPConfigurations),
 			),
 		},
 		data.ImportStep(),
@@ -63,6 +65,25 @@ func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_requiresImport(t
 	})
 }
 
+func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_importInvalidId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_association", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			ResourceName:  data.ResourceName,
+			ImportState:   true,
+			ImportStateId: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1",
+			ExpectError:   regexp.MustCompile(`expected ID to be in the format {networkInterfaceId}\|{applicationSecurityGroupId}`),
+		},
+	})
+}
+
 func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_deleted(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_association", "test")
 	r := NetworkInterfaceApplicationSecurityGroupAssociationResource{}
@@ -79,6 +100,30 @@ func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_deleted(t *testi
 	})
 }
 
+func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_recreatedAfterRemovedOutOfBand(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_association", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.destroy),
+			),
+			ExpectNonEmptyPlan: true,
+		},
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.associatedWithAllIPConfigurations),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetworkInterfaceApplicationSecurityGroupAssociation_updateNIC(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_association", "test")
 	r := NetworkInterfaceApplicationSecurityGroupAssociationResource{}
@@ -134,6 +179,40 @@ func (t NetworkInterfaceApplicationSecurityGroupAssociationResource) Exists(ctx
 	return utils.Bool(found), nil
 }
 
+func (NetworkInterfaceApplicationSecurityGroupAssociationResource) associatedWithAllIPConfigurations(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
+	nicID, err := azure.ParseAzureResourceID(state.Attributes["network_interface_id"])
+	if err != nil {
+		return err
+	}
+
+	nicName := nicID.Path["networkInterfaces"]
+	resourceGroup := nicID.ResourceGroup
+	applicationSecurityGroupId := state.Attributes["application_security_group_id"]
+
+	read, err := client.Network.InterfacesClient.Get(ctx, resourceGroup, nicName, "")
+	if err != nil {
+		return fmt.Errorf("retrieving Network Interface %q (Resource Group %q): %+v", nicName, resourceGroup, err)
+	}
+
+	for _, config := range *read.InterfacePropertiesFormat.IPConfigurations {
+		found := false
+		if config.ApplicationSecurityGroups != nil {
+			for _, group := range *config.ApplicationSecurityGroups {
+				if group.ID != nil && strings.EqualFold(*group.ID, applicationSecurityGroupId) {
+					found = true
+					break
+				}
+			}
+		}
+
+		if !found {
+			return fmt.Errorf("Application Security Group %q was not associated with IP Configuration %q", applicationSecurityGroupId, *config.Name)
+		}
+	}
+
+	return nil
+}
+
 func (NetworkInterfaceApplicationSecurityGroupAssociationResource) destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) error {
 	nicID, err := azure.ParseAzureResourceID(state.Attributes["network_interface_id"])
 	if err != nil {
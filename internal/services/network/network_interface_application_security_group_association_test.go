@@ -0,0 +1,166 @@
+package network
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNetworkInterfaceApplicationSecurityGroupIDs(t *testing.T) {
+	apiIds := []string{
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/Group1/providers/Microsoft.Network/applicationSecurityGroups/ASG1",
+		"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg2",
+	}
+
+	cases := []struct {
+		Name             string
+		Input            string
+		ExpectedContains bool
+		ExpectedWithout  []string
+	}{
+		{
+			Name:             "Not Associated",
+			Input:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg3",
+			ExpectedContains: false,
+			ExpectedWithout:  apiIds,
+		},
+		{
+			Name:             "Same Casing",
+			Input:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg2",
+			ExpectedContains: true,
+			ExpectedWithout:  apiIds[:1],
+		},
+		{
+			Name:             "Mixed Casing",
+			Input:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourcegroups/group1/providers/Microsoft.Network/applicationSecurityGroups/asg1",
+			ExpectedContains: true,
+			ExpectedWithout:  apiIds[1:],
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := networkInterfaceApplicationSecurityGroupIDsContain(apiIds, v.Input); actual != v.ExpectedContains {
+			t.Fatalf("Expected contains to be %t but got %t", v.ExpectedContains, actual)
+		}
+
+		if actual := networkInterfaceApplicationSecurityGroupIDsWithout(apiIds, v.Input); !reflect.DeepEqual(actual, v.ExpectedWithout) {
+			t.Fatalf("Expected %+v but got %+v", v.ExpectedWithout, actual)
+		}
+	}
+}
+
+func TestNetworkInterfaceUpdateWasConflict(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    *http.Response
+		Expected bool
+	}{
+		{
+			Name:     "No Response",
+			Input:    nil,
+			Expected: false,
+		},
+		{
+			Name:     "OK",
+			Input:    &http.Response{StatusCode: http.StatusOK},
+			Expected: false,
+		},
+		{
+			Name:     "Bad Request",
+			Input:    &http.Response{StatusCode: http.StatusBadRequest},
+			Expected: false,
+		},
+		{
+			Name:     "Conflict",
+			Input:    &http.Response{StatusCode: http.StatusConflict},
+			Expected: true,
+		},
+		{
+			Name:     "Precondition Failed",
+			Input:    &http.Response{StatusCode: http.StatusPreconditionFailed},
+			Expected: true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		if actual := networkInterfaceUpdateWasConflict(v.Input); actual != v.Expected {
+			t.Fatalf("Expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestRetryNetworkInterfaceUpdate(t *testing.T) {
+	cases := []struct {
+		Name          string
+		StatusCodes   []int
+		ExpectedCalls int
+		ExpectError   bool
+	}{
+		{
+			Name:          "Updated",
+			StatusCodes:   []int{http.StatusOK},
+			ExpectedCalls: 1,
+		},
+		{
+			Name:          "Conflict",
+			StatusCodes:   []int{http.StatusConflict, http.StatusOK},
+			ExpectedCalls: 2,
+		},
+		{
+			Name:          "Precondition Failed",
+			StatusCodes:   []int{http.StatusPreconditionFailed, http.StatusConflict, http.StatusOK},
+			ExpectedCalls: 3,
+		},
+		{
+			Name:          "Bad Request",
+			StatusCodes:   []int{http.StatusBadRequest},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+		{
+			Name:          "No Response",
+			StatusCodes:   []int{0},
+			ExpectedCalls: 1,
+			ExpectError:   true,
+		},
+	}
+
+	for _, v := range cases {
+		t.Logf("[DEBUG] Testing %q", v.Name)
+
+		calls := 0
+		updateFunc := func() (*http.Response, error) {
+			if calls >= len(v.StatusCodes) {
+				return nil, fmt.Errorf("unexpected call %d", calls+1)
+			}
+			statusCode := v.StatusCodes[calls]
+			calls++
+
+			if statusCode == 0 {
+				return nil, fmt.Errorf("retrieving Network Interface")
+			}
+			if statusCode >= http.StatusBadRequest {
+				return &http.Response{StatusCode: statusCode}, fmt.Errorf("unexpected status %d", statusCode)
+			}
+			return &http.Response{StatusCode: statusCode}, nil
+		}
+
+		err := retryNetworkInterfaceUpdate(time.Minute, updateFunc)
+		if v.ExpectError && err == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+		if !v.ExpectError && err != nil {
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+
+		if calls != v.ExpectedCalls {
+			t.Fatalf("Expected %d calls but got %d", v.ExpectedCalls, calls)
+		}
+	}
+}
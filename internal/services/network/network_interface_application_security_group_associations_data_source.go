@@ -0,0 +1,115 @@
+package network
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func dataSourceNetworkInterfaceApplicationSecurityGroupAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceNetworkInterfaceApplicationSecurityGroupAssociationsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"application_security_group_ids": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+
+			"associations": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"application_security_group_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkInterfaceApplicationSecurityGroupAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return fmt.Errorf("%s was not found", *id)
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	info := parseFieldsFromNetworkInterface(*props)
+	applicationSecurityGroupIds := info.applicationSecurityGroupIDs
+	sort.Strings(applicationSecurityGroupIds)
+
+	d.SetId(id.ID())
+
+	if err := d.Set("application_security_group_ids", applicationSecurityGroupIds); err != nil {
+		return fmt.Errorf("setting `application_security_group_ids`: %+v", err)
+	}
+
+	if err := d.Set("associations", flattenNetworkInterfaceApplicationSecurityGroupAssociations(id.ID(), applicationSecurityGroupIds)); err != nil {
+		return fmt.Errorf("setting `associations`: %+v", err)
+	}
+
+	return nil
+}
+
+// flattenNetworkInterfaceApplicationSecurityGroupAssociations returns the associations between the Network Interface
+// and each Application Security Group, using the ID format of the `azurerm_network_interface_application_security_group_association`
+// resource so that these can be imported
+func flattenNetworkInterfaceApplicationSecurityGroupAssociations(networkInterfaceId string, applicationSecurityGroupIds []string) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, applicationSecurityGroupId := range applicationSecurityGroupIds {
+		results = append(results, map[string]interface{}{
+			"id":                            fmt.Sprintf("%s|%s", networkInterfaceId, applicationSecurityGroupId),
+			"application_security_group_id": applicationSecurityGroupId,
+		})
+	}
+
+	return results
+}
@@ -0,0 +1,41 @@
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type NetworkInterfaceApplicationSecurityGroupAssociationsDataSource struct {
+}
+
+func TestAccDataSourceNetworkInterfaceApplicationSecurityGroupAssociations_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_interface_application_security_group_associations", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("application_security_group_ids.#").HasValue("2"),
+				check.That(data.ResourceName).Key("associations.#").HasValue("2"),
+				check.That(data.ResourceName).Key("associations.0.id").Exists(),
+				check.That(data.ResourceName).Key("associations.0.application_security_group_id").Exists(),
+				check.That(data.ResourceName).Key("associations.1.id").Exists(),
+				check.That(data.ResourceName).Key("associations.1.application_security_group_id").Exists(),
+			),
+		},
+	})
+}
+
+func (NetworkInterfaceApplicationSecurityGroupAssociationsDataSource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_application_security_group_associations" "test" {
+  network_interface_id = azurerm_network_interface_application_security_group_associations.test.network_interface_id
+}
+`, NetworkInterfaceApplicationSecurityGroupAssociationsResource{}.multiple(data))
+}
@@ -0,0 +1,187 @@
+package network
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+func resourceNetworkInterfaceApplicationSecurityGroupAssociations() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceNetworkInterfaceApplicationSecurityGroupAssociationsCreate,
+		Read:   resourceNetworkInterfaceApplicationSecurityGroupAssociationsRead,
+		Update: resourceNetworkInterfaceApplicationSecurityGroupAssociationsUpdate,
+		Delete: resourceNetworkInterfaceApplicationSecurityGroupAssociationsDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.NetworkInterfaceID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"network_interface_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.NetworkInterfaceID,
+			},
+
+			"application_security_group_ids": {
+				Type:     pluginsdk.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: azure.ValidateResourceID,
+				},
+			},
+		},
+	}
+}
+
+func resourceNetworkInterfaceApplicationSecurityGroupAssociationsCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Get("network_interface_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+
+	applicationSecurityGroupIds := *utils.ExpandStringSlice(d.Get("application_security_group_ids").(*pluginsdk.Set).List())
+	err = updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx, client, id.ResourceGroup, id.Name, d.Timeout(pluginsdk.TimeoutCreate), func(info *networkInterfaceUpdateInformation) error {
+		// this resource manages all of the Application Security Groups on the Network Interface, so any which
+		// are already associated need to be imported first
+		if len(info.applicationSecurityGroupIDs) > 0 {
+			return tf.ImportAsExistsError("azurerm_network_interface_application_security_group_associations", id.ID())
+		}
+		info.applicationSecurityGroupIDs = applicationSecurityGroupIds
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(id.ID())
+
+	return resourceNetworkInterfaceApplicationSecurityGroupAssociationsRead(d, meta)
+}
+
+func resourceNetworkInterfaceApplicationSecurityGroupAssociationsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", *id)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	props := read.InterfacePropertiesFormat
+	if props == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", *id)
+	}
+
+	info := parseFieldsFromNetworkInterface(*props)
+
+	// the casing of the IDs returned from the API can differ to the one used when associating the groups
+	applicationSecurityGroupIds := make([]string, 0)
+	for _, v := range info.applicationSecurityGroupIDs {
+		applicationSecurityGroupId, err := parse.ApplicationSecurityGroupIDInsensitively(v)
+		if err != nil {
+			return err
+		}
+		applicationSecurityGroupIds = append(applicationSecurityGroupIds, applicationSecurityGroupId.ID())
+	}
+
+	d.Set("network_interface_id", id.ID())
+	d.Set("application_security_group_ids", applicationSecurityGroupIds)
+
+	return nil
+}
+
+func resourceNetworkInterfaceApplicationSecurityGroupAssociationsUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+
+	applicationSecurityGroupIds := *utils.ExpandStringSlice(d.Get("application_security_group_ids").(*pluginsdk.Set).List())
+	err = updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx, client, id.ResourceGroup, id.Name, d.Timeout(pluginsdk.TimeoutUpdate), func(info *networkInterfaceUpdateInformation) error {
+		info.applicationSecurityGroupIDs = applicationSecurityGroupIds
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return resourceNetworkInterfaceApplicationSecurityGroupAssociationsRead(d, meta)
+}
+
+func resourceNetworkInterfaceApplicationSecurityGroupAssociationsDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.InterfacesClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.NetworkInterfaceID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.Name, networkInterfaceResourceName)
+	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+
+	// when the Network Interface has already been deleted there's nothing to disassociate
+	read, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return nil
+		}
+
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return updateNetworkInterfaceApplicationSecurityGroupAssociation(ctx, client, id.ResourceGroup, id.Name, d.Timeout(pluginsdk.TimeoutDelete), func(info *networkInterfaceUpdateInformation) error {
+		info.applicationSecurityGroupIDs = []string{}
+		return nil
+	})
+}
@@ -0,0 +1,193 @@
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type NetworkInterfaceApplicationSecurityGroupAssociationsResource struct {
+}
+
+func TestAccNetworkInterfaceApplicationSecurityGroupAssociations_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_associations", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_security_group_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceApplicationSecurityGroupAssociations_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_associations", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_security_group_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_security_group_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("application_security_group_ids.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccNetworkInterfaceApplicationSecurityGroupAssociations_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_interface_application_security_group_associations", "test")
+	r := NetworkInterfaceApplicationSecurityGroupAssociationsResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.requiresImport(data),
+			ExpectError: acceptance.RequiresImportError("azurerm_network_interface_application_security_group_associations"),
+		},
+	})
+}
+
+func (t NetworkInterfaceApplicationSecurityGroupAssociationsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.NetworkInterfaceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	read, err := clients.Network.InterfacesClient.Get(ctx, id.ResourceGroup, id.Name, "")
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %+v", *id, err)
+	}
+
+	found := false
+	if props := read.InterfacePropertiesFormat; props != nil && props.IPConfigurations != nil {
+		for _, config := range *props.IPConfigurations {
+			if config.ApplicationSecurityGroups != nil && len(*config.ApplicationSecurityGroups) > 0 {
+				found = true
+				break
+			}
+		}
+	}
+
+	return utils.Bool(found), nil
+}
+
+func (r NetworkInterfaceApplicationSecurityGroupAssociationsResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_application_security_group_associations" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+  application_security_group_ids = [
+    azurerm_application_security_group.test.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceApplicationSecurityGroupAssociationsResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_application_security_group_associations" "test" {
+  network_interface_id = azurerm_network_interface.test.id
+  application_security_group_ids = [
+    azurerm_application_security_group.test.id,
+    azurerm_application_security_group.second.id,
+  ]
+}
+`, r.template(data))
+}
+
+func (r NetworkInterfaceApplicationSecurityGroupAssociationsResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_interface_application_security_group_associations" "import" {
+  network_interface_id           = azurerm_network_interface_application_security_group_associations.test.network_interface_id
+  application_security_group_ids = azurerm_network_interface_application_security_group_associations.test.application_security_group_ids
+}
+`, r.basic(data))
+}
+
+func (NetworkInterfaceApplicationSecurityGroupAssociationsResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_application_security_group" "test" {
+  name                = "acctest-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_application_security_group" "second" {
+  name                = "acctest2-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = azurerm_subnet.test.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger, data.RandomInteger, data.RandomInteger, data.RandomInteger)
+}
@@ -0,0 +1,113 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+)
+
+type ApplicationSecurityGroupId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func NewApplicationSecurityGroupID(subscriptionId, resourceGroup, name string) ApplicationSecurityGroupId {
+	return ApplicationSecurityGroupId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Name:           name,
+	}
+}
+
+func (id ApplicationSecurityGroupId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Application Security Group", segmentsStr)
+}
+
+func (id ApplicationSecurityGroupId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+// ApplicationSecurityGroupID parses a ApplicationSecurityGroup ID into an ApplicationSecurityGroupId struct
+func ApplicationSecurityGroupID(input string) (*ApplicationSecurityGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationSecurityGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.Name, err = id.PopSegment("applicationSecurityGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
+
+// ApplicationSecurityGroupIDInsensitively parses an ApplicationSecurityGroup ID into an ApplicationSecurityGroupId struct, insensitively
+// This should only be used to parse an ID for rewriting, the ApplicationSecurityGroupID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func ApplicationSecurityGroupIDInsensitively(input string) (*ApplicationSecurityGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApplicationSecurityGroupId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	// find the correct casing for the 'applicationSecurityGroups' segment
+	applicationSecurityGroupsKey := "applicationSecurityGroups"
+	for key := range id.Path {
+		if strings.EqualFold(key, applicationSecurityGroupsKey) {
+			applicationSecurityGroupsKey = key
+			break
+		}
+	}
+	if resourceId.Name, err = id.PopSegment(applicationSecurityGroupsKey); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
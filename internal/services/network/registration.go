@@ -21,32 +21,35 @@ func (r Registration) WebsiteCategories() []string {
 // SupportedDataSources returns the supported Data Sources supported by this Service
 func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
 	return map[string]*pluginsdk.Resource{
-		"azurerm_application_gateway":                       dataSourceApplicationGateway(),
-		"azurerm_application_security_group":                dataSourceApplicationSecurityGroup(),
-		"azurerm_express_route_circuit":                     dataSourceExpressRouteCircuit(),
-		"azurerm_ip_group":                                  dataSourceIpGroup(),
-		"azurerm_nat_gateway":                               dataSourceNatGateway(),
-		"azurerm_network_ddos_protection_plan":              dataSourceNetworkDDoSProtectionPlan(),
-		"azurerm_network_interface":                         dataSourceNetworkInterface(),
-		"azurerm_network_security_group":                    dataSourceNetworkSecurityGroup(),
-		"azurerm_network_watcher":                           dataSourceNetworkWatcher(),
-		"azurerm_private_endpoint_connection":               dataSourcePrivateEndpointConnection(),
-		"azurerm_private_link_service":                      dataSourcePrivateLinkService(),
-		"azurerm_private_link_service_endpoint_connections": dataSourcePrivateLinkServiceEndpointConnections(),
-		"azurerm_public_ip":                                 dataSourcePublicIP(),
-		"azurerm_public_ips":                                dataSourcePublicIPs(),
-		"azurerm_public_ip_prefix":                          dataSourcePublicIpPrefix(),
-		"azurerm_route_filter":                              dataSourceRouteFilter(),
-		"azurerm_route_table":                               dataSourceRouteTable(),
-		"azurerm_network_service_tags":                      dataSourceNetworkServiceTags(),
-		"azurerm_subnet":                                    dataSourceSubnet(),
-		"azurerm_virtual_hub":                               dataSourceVirtualHub(),
-		"azurerm_virtual_network_gateway":                   dataSourceVirtualNetworkGateway(),
-		"azurerm_virtual_network_gateway_connection":        dataSourceVirtualNetworkGatewayConnection(),
-		"azurerm_virtual_network":                           dataSourceVirtualNetwork(),
-		"azurerm_web_application_firewall_policy":           dataWebApplicationFirewallPolicy(),
-		"azurerm_virtual_wan":                               dataSourceVirtualWan(),
-		"azurerm_local_network_gateway":                     dataSourceLocalNetworkGateway(),
+		"azurerm_application_gateway":                                       dataSourceApplicationGateway(),
+		"azurerm_application_security_group":                                dataSourceApplicationSecurityGroup(),
+		"azurerm_express_route_circuit":                                     dataSourceExpressRouteCircuit(),
+		"azurerm_express_route_circuit_authorization":                       dataSourceExpressRouteCircuitAuthorization(),
+		"azurerm_express_route_circuit_authorizations":                      dataSourceExpressRouteCircuitAuthorizations(),
+		"azurerm_ip_group":                                                  dataSourceIpGroup(),
+		"azurerm_nat_gateway":                                               dataSourceNatGateway(),
+		"azurerm_network_ddos_protection_plan":                              dataSourceNetworkDDoSProtectionPlan(),
+		"azurerm_network_interface":                                         dataSourceNetworkInterface(),
+		"azurerm_network_interface_application_security_group_associations": dataSourceNetworkInterfaceApplicationSecurityGroupAssociations(),
+		"azurerm_network_security_group":                                    dataSourceNetworkSecurityGroup(),
+		"azurerm_network_watcher":                                           dataSourceNetworkWatcher(),
+		"azurerm_private_endpoint_connection":                               dataSourcePrivateEndpointConnection(),
+		"azurerm_private_link_service":                                      dataSourcePrivateLinkService(),
+		"azurerm_private_link_service_endpoint_connections":                 dataSourcePrivateLinkServiceEndpointConnections(),
+		"azurerm_public_ip":                                                 dataSourcePublicIP(),
+		"azurerm_public_ips":                                                dataSourcePublicIPs(),
+		"azurerm_public_ip_prefix":                                          dataSourcePublicIpPrefix(),
+		"azurerm_route_filter":                                              dataSourceRouteFilter(),
+		"azurerm_route_table":                                               dataSourceRouteTable(),
+		"azurerm_network_service_tags":                                      dataSourceNetworkServiceTags(),
+		"azurerm_subnet":                                                    dataSourceSubnet(),
+		"azurerm_virtual_hub":                                               dataSourceVirtualHub(),
+		"azurerm_virtual_network_gateway":                                   dataSourceVirtualNetworkGateway(),
+		"azurerm_virtual_network_gateway_connection":                        dataSourceVirtualNetworkGatewayConnection(),
+		"azurerm_virtual_network":                                           dataSourceVirtualNetwork(),
+		"azurerm_web_application_firewall_policy":                           dataWebApplicationFirewallPolicy(),
+		"azurerm_virtual_wan":                                               dataSourceVirtualWan(),
+		"azurerm_local_network_gateway":                                     dataSourceLocalNetworkGateway(),
 	}
 }
 
@@ -74,6 +77,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 		"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
 		"azurerm_network_interface_application_security_group_association":               resourceNetworkInterfaceApplicationSecurityGroupAssociation(),
+		"azurerm_network_interface_application_security_group_associations":              resourceNetworkInterfaceApplicationSecurityGroupAssociations(),
 		"azurerm_network_interface_backend_address_pool_association":                     resourceNetworkInterfaceBackendAddressPoolAssociation(),
 		"azurerm_network_interface_nat_rule_association":                                 resourceNetworkInterfaceNatRuleAssociation(),
 		"azurerm_network_interface_security_group_association":                           resourceNetworkInterfaceSecurityGroupAssociation(),
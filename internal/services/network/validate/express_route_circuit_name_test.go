@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExpressRouteCircuitName(t *testing.T) {
+	testCases := []struct {
+		Input    string
+		Expected bool
+	}{
+		{
+			Input:    "",
+			Expected: false,
+		},
+		{
+			Input:    strings.Repeat("s", 80),
+			Expected: true,
+		},
+		{
+			Input:    strings.Repeat("s", 81),
+			Expected: false,
+		},
+		{
+			Input:    "_",
+			Expected: false,
+		},
+		{
+			Input:    "a",
+			Expected: true,
+		},
+		{
+			Input:    "a_",
+			Expected: true,
+		},
+		{
+			Input:    "a-",
+			Expected: false,
+		},
+		{
+			Input:    "a.b-c_d",
+			Expected: true,
+		},
+		{
+			Input:    "a/b",
+			Expected: false,
+		},
+	}
+	for _, v := range testCases {
+		_, errors := ExpressRouteCircuitName(v.Input, "express_route_circuit_name")
+		result := len(errors) == 0
+		if result != v.Expected {
+			t.Fatalf("Expected the result to be %t but got %t (and %d errors)", v.Expected, result, len(errors))
+		}
+	}
+}